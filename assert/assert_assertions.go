@@ -6,6 +6,8 @@
 package assert
 
 import (
+	"context"
+	"io/fs"
 	"iter"
 	"net/http"
 	"net/url"
@@ -57,6 +59,38 @@ func BlockedT[E any, CHAN ~chan E](t T, ch CHAN, msgAndArgs ...any) bool {
 	return assertions.BlockedT[E, CHAN](t, ch, msgAndArgs...)
 }
 
+// CompletesWithin asserts that the function completes within the given duration.
+//
+// The elapsed time is measured with the monotonic clock.
+//
+// The assertion fails as soon as the duration is exceeded, without waiting for the function to complete:
+// in that case the function keeps running in the background.
+//
+// A panic in the function is propagated if it occurs before the duration is exceeded.
+// The assertion fails if the function exits with [runtime.Goexit], e.g. after calling FailNow.
+//
+// The maximum and measured durations are reported as the expected and actual values
+// of the [Failure] passed to [FailureReporter] hooks.
+//
+// # Usage
+//
+//	assertions.CompletesWithin(t, 100*time.Millisecond, func() {
+//		_ = parse(input)
+//	})
+//
+// # Examples
+//
+//	success: time.Second, func() {}
+//	failure: 10*time.Millisecond, func() { time.Sleep(100*time.Millisecond) }
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func CompletesWithin(t T, d time.Duration, fn func(), msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.CompletesWithin(t, d, fn, msgAndArgs...)
+}
+
 // Condition uses a comparison function to assert a complex condition.
 //
 // # Usage
@@ -164,6 +198,31 @@ func Contains(t T, s any, contains any, msgAndArgs ...any) bool {
 	return assertions.Contains(t, s, contains, msgAndArgs...)
 }
 
+// DirEqual asserts that two directory trees contain the same files, with the same content.
+//
+// Only regular files are compared: empty directories and other entries, e.g. symbolic links, are ignored.
+//
+// On failure, a summary lists the files missing from actual, the unexpected files in actual
+// and the files with a different content.
+// The content of differing files is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.DirEqual(t, "testdata/golden", "path/to/generated")
+//
+// # Examples
+//
+//	success: filepath.Join(testDataPath(),"existing_dir"), filepath.Join(testDataPath(),"existing_dir")
+//	failure: testDataPath(), filepath.Join(testDataPath(),"existing_dir")
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func DirEqual(t T, expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.DirEqual(t, expected, actual, msgAndArgs...)
+}
+
 // DirExists checks whether a directory exists in the given path. It also fails
 // if the path is a file rather a directory or there is an error checking whether it exists.
 //
@@ -282,6 +341,9 @@ func Empty(t T, object any, msgAndArgs ...any) bool {
 //
 // Function equality cannot be determined and will always fail.
 //
+// Custom comparers configured with [WithComparers] take precedence for the types they support.
+// Struct fields configured with [WithIgnoredFields] are ignored.
+//
 // # Usage
 //
 //	assertions.Equal(t, 123, 123)
@@ -349,6 +411,37 @@ func EqualExportedValues(t T, expected any, actual any, msgAndArgs ...any) bool
 	return assertions.EqualExportedValues(t, expected, actual, msgAndArgs...)
 }
 
+// EqualIgnoringFields asserts that two objects are equal, ignoring the struct fields with the given names.
+//
+// The ignored fields are zeroed on copies of both objects before comparing them with [Equal].
+// This avoids copying structs around just to reset volatile fields, e.g. identifiers or timestamps.
+//
+// A field name applies to exported struct fields at any depth, including
+// in nested structs, pointers, slices, arrays, map values and interfaces.
+// Fields promoted from embedded structs are ignored too, even when the embedded type is unexported.
+// Map keys are left unchanged.
+//
+// The assertion fails if a field name doesn't match any exported struct field.
+//
+// To ignore fields in all the calls to [Equal] in a test, use [WithIgnoredFields].
+//
+// # Usage
+//
+//	assertions.EqualIgnoringFields(t, expected, actual, []string{"ID", "CreatedAt"})
+//
+// # Examples
+//
+//	success: dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}
+//	failure: dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func EqualIgnoringFields(t T, expected any, actual any, fields []string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.EqualIgnoringFields(t, expected, actual, fields, msgAndArgs...)
+}
+
 // EqualT asserts that two objects of the same comparable type are equal.
 //
 // Pointer variable equality is determined based on the equality of the memory addresses (unlike [Equal], but like [Same]).
@@ -440,6 +533,31 @@ func ErrorAs(t T, err error, target any, msgAndArgs ...any) bool {
 	return assertions.ErrorAs(t, err, target, msgAndArgs...)
 }
 
+// ErrorAsType asserts that at least one of the errors in err's chain matches the type E,
+// and if so, returns that error value.
+//
+// This is a wrapper for [errors.As] that saves the declaration of a target variable.
+// The type parameter E cannot be inferred and must be specified explicitly.
+//
+// It returns the zero value of E and false if no error in the chain matches.
+//
+// # Usage
+//
+//	target, ok := assertions.ErrorAsType[*MyError](t, err)
+//
+// # Examples
+//
+//	success: fmt.Errorf("wrap: %w", &dummyError{})
+//	failure: ErrTest
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func ErrorAsType[E error](t T, err error, msgAndArgs ...any) (E, bool) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ErrorAsType[E](t, err, msgAndArgs...)
+}
+
 // ErrorContains asserts that a function returned a non-nil error (i.e. an
 // error) and that the error contains the specified substring.
 //
@@ -655,6 +773,53 @@ func EventuallyWith[C CollectibleConditioner](t T, condition C, timeout time.Dur
 	return assertions.EventuallyWith[C](t, condition, timeout, tick, msgAndArgs...)
 }
 
+// EventuallyWithContext asserts that the given condition will be met before the
+// context is done, periodically checking the target function on each tick.
+//
+// [EventuallyWithContext] behaves like [Eventually], except that polling is bounded
+// by the provided context rather than by a fixed timeout. This is convenient for tests
+// which already carry a deadline, e.g. with [testing.T.Context] or a context derived from it.
+//
+// When the condition is never satisfied, the failure reports whether polling stopped
+// because the context deadline was exceeded or because the context was cancelled.
+//
+// A nil context fails the assertion immediately.
+//
+// # Usage
+//
+//	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+//	defer cancel()
+//
+//	assertions.EventuallyWithContext(t, ctx, func() bool { return true }, 10*time.Millisecond)
+//
+// # Concurrency
+//
+// The condition is executed with the same guarantees as for [Eventually]: serially,
+// by a single goroutine, at least once.
+//
+// When using the func(context.Context) error form, the condition receives a context
+// derived from ctx.
+//
+// # Synctest
+//
+// The [WithSynctest] and [WithSynctestContext] wrappers are accepted, but polling always
+// runs in real time: the provided context lives outside of any [testing/synctest] bubble.
+//
+// See also [Eventually] for details about panic recovery.
+//
+// # Examples
+//
+//	success: context.Background(), func() bool { return true }, 20*time.Millisecond
+//	failure: func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func EventuallyWithContext[C Conditioner](t T, ctx context.Context, condition C, tick time.Duration, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.EventuallyWithContext[C](t, ctx, condition, tick, msgAndArgs...)
+}
+
 // Exactly asserts that two objects are equal in value and type.
 //
 // # Usage
@@ -674,6 +839,32 @@ func Exactly(t T, expected any, actual any, msgAndArgs ...any) bool {
 	return assertions.Exactly(t, expected, actual, msgAndArgs...)
 }
 
+// FSEqual asserts that two file systems contain the same files, with the same content.
+//
+// This is useful to compare an [fs.FS] produced by a test (e.g. an in-memory file system)
+// with a golden directory, using [os.DirFS].
+//
+// Only regular files are compared: empty directories and other entries, e.g. symbolic links, are ignored.
+//
+// The content of differing files is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FSEqual(t, os.DirFS("testdata/golden"), generated)
+//
+// # Examples
+//
+//	success: os.DirFS(testDataPath()), os.DirFS(testDataPath())
+//	failure: os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(),"existing_dir"))
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FSEqual(t T, expected fs.FS, actual fs.FS, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FSEqual(t, expected, actual, msgAndArgs...)
+}
+
 // Fail reports a failure through.
 //
 // # Usage
@@ -773,6 +964,53 @@ func FileEmpty(t T, path string, msgAndArgs ...any) bool {
 	return assertions.FileEmpty(t, path, msgAndArgs...)
 }
 
+// FileEqual asserts that two files have the same content.
+//
+// This is typically used to compare a generated file against a golden file.
+// It fails if any of the paths cannot be read, or points to a directory.
+//
+// On failure, the content is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FileEqual(t, "testdata/golden.txt", "path/to/generated.txt")
+//
+// # Examples
+//
+//	success: filepath.Join(testDataPath(),"existing_file"), filepath.Join(testDataPath(),"existing_file")
+//	failure: filepath.Join(testDataPath(),"existing_file"), filepath.Join(testDataPath(),"empty_file")
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FileEqual(t T, expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqual(t, expected, actual, msgAndArgs...)
+}
+
+// FileEqualBytes asserts that the content of a file is equal to the expected bytes.
+//
+// It fails if the path cannot be read, or points to a directory.
+//
+// On failure, the content is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FileEqualBytes(t, []byte("hello\n"), "path/to/file")
+//
+// # Examples
+//
+//	success: []byte("NOT EMPTY\n"), filepath.Join(testDataPath(),"existing_file")
+//	failure: []byte("EMPTY\n"), filepath.Join(testDataPath(),"existing_file")
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FileEqualBytes(t T, expected []byte, actual string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqualBytes(t, expected, actual, msgAndArgs...)
+}
+
 // FileExists checks whether a file exists in the given path. It also fails if
 // the path points to a directory or there is an error when trying to check the file.
 //
@@ -946,6 +1184,41 @@ func GreaterT[Orderable Ordered](t T, e1 Orderable, e2 Orderable, msgAndArgs ...
 	return assertions.GreaterT[Orderable](t, e1, e2, msgAndArgs...)
 }
 
+// Group runs a block of assertions and reports all their failures together, at the end of the block.
+//
+// This gives "soft assertion" semantics to long validations, e.g. when checking all the fields of a large
+// table: every failed assertion in the block is reported, not just the first one.
+//
+// The block is supplied with a [CollectT], which collects the failures of the assertions called with it.
+// Calling [CollectT.FailNow] (directly, or transitively through [require] assertions) or [CollectT.Cancel]
+// stops the block. The failures collected so far are reported.
+// A stopped block fails the group, even when no assertion failed: e.g. [CollectT.Cancel] reports "group cancelled".
+//
+// Forward methods may be used in the block by wrapping the [CollectT], e.g. with assert.New(c).
+//
+// The options configured for t, e.g. with [WithComparers], apply to the assertions in the block.
+//
+// # Usage
+//
+//	assertions.Group(t, func(c *assertions.CollectT) {
+//		for _, row := range rows {
+//			assertions.Equal(c, row.Expected, row.Actual, "row %d", row.ID)
+//		}
+//	})
+//
+// # Examples
+//
+//	success: func(c *CollectT) { True(c, true); Equal(c, 1, 1) }
+//	failure: func(c *CollectT) { True(c, false); Equal(c, 1, 2) }
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func Group(t T, block func(*CollectT), msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.Group(t, block, msgAndArgs...)
+}
+
 // HTTPBodyContains asserts that a specified handler returns a body that contains a string.
 //
 // Returns whether the assertion was successful (true) or not (false).
@@ -967,6 +1240,30 @@ func HTTPBodyContains(t T, handler http.HandlerFunc, method string, url string,
 	return assertions.HTTPBodyContains(t, handler, method, url, values, str, msgAndArgs...)
 }
 
+// HTTPBodyJSONEq asserts that a specified handler returns a body that is semantically
+// equivalent to the expected JSON document.
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// See also [JSONEq].
+//
+// # Usage
+//
+//	assertions.HTTPBodyJSONEq(t, myHandler, "GET", "/api/v1/users/1", nil, `{"id": 1, "name": "Alice"}`)
+//
+// # Examples
+//
+//	success: httpJSON, "GET", "/", nil, `{"hello": "world"}`
+//	failure: httpJSON, "GET", "/", nil, `{"hello": "bob"}`
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPBodyJSONEq(t T, handler http.HandlerFunc, method string, url string, values url.Values, expected string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPBodyJSONEq(t, handler, method, url, values, expected, msgAndArgs...)
+}
+
 // HTTPBodyNotContains asserts that a specified handler returns a
 // body that does not contain a string.
 //
@@ -1010,6 +1307,30 @@ func HTTPError(t T, handler http.HandlerFunc, method string, url string, values
 	return assertions.HTTPError(t, handler, method, url, values, msgAndArgs...)
 }
 
+// HTTPHeader asserts that a specified handler returns a response header with the expected value.
+//
+// Only the first value of the header is considered (see [http.Header.Get]).
+// A header set with no value, e.g. to suppress an automatic header, is considered empty.
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// # Usage
+//
+//	assertions.HTTPHeader(t, myHandler, "GET", "/api/v1/users/1", nil, "Content-Type", "application/json")
+//
+// # Examples
+//
+//	success: httpJSON, "GET", "/", nil, "Content-Type", "application/json"
+//	failure: httpJSON, "GET", "/", nil, "Content-Type", "text/plain"
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPHeader(t T, handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPHeader(t, handler, method, url, values, header, expected, msgAndArgs...)
+}
+
 // HTTPRedirect asserts that a specified handler returns a redirect status code.
 //
 // Returns whether the assertion was successful (true) or not (false).
@@ -1031,6 +1352,59 @@ func HTTPRedirect(t T, handler http.HandlerFunc, method string, url string, valu
 	return assertions.HTTPRedirect(t, handler, method, url, values, msgAndArgs...)
 }
 
+// HTTPRoundTripBodyJSONEq asserts that sending a request through a [http.RoundTripper]
+// returns a body that is semantically equivalent to the expected JSON document.
+//
+// A nil round tripper stands for [http.DefaultTransport].
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// See also [JSONEq].
+//
+// # Usage
+//
+//	req, _ := http.NewRequestWithContext(t.Context(), "GET", server.URL+"/api/v1/users/1", http.NoBody)
+//	assertions.HTTPRoundTripBodyJSONEq(t, myTransport, req, `{"id": 1, "name": "Alice"}`)
+//
+// # Examples
+//
+//	success: httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`
+//	failure: httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPRoundTripBodyJSONEq(t T, rt http.RoundTripper, req *http.Request, expected string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripBodyJSONEq(t, rt, req, expected, msgAndArgs...)
+}
+
+// HTTPRoundTripStatusCode asserts that sending a request through a [http.RoundTripper]
+// returns a specified status code.
+//
+// Unlike [HTTPStatusCode], this exercises a client transport, e.g. a chain of client middlewares.
+// A nil round tripper stands for [http.DefaultTransport].
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// # Usage
+//
+//	req, _ := http.NewRequestWithContext(t.Context(), "GET", server.URL+"/health", http.NoBody)
+//	assertions.HTTPRoundTripStatusCode(t, myTransport, req, http.StatusOK)
+//
+// # Examples
+//
+//	success: httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK
+//	failure: httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPRoundTripStatusCode(t T, rt http.RoundTripper, req *http.Request, statuscode int, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripStatusCode(t, rt, req, statuscode, msgAndArgs...)
+}
+
 // HTTPStatusCode asserts that a specified handler returns a specified status code.
 //
 // Returns whether the assertion was successful (true) or not (false).
@@ -1721,6 +2095,63 @@ func JSONMarshalAsT[EDoc RText](t T, expected EDoc, object any, msgAndArgs ...an
 	return assertions.JSONMarshalAsT[EDoc](t, expected, object, msgAndArgs...)
 }
 
+// JSONMatches asserts that a JSON document matches the expected JSON document,
+// ignoring any field in actual that is not present in expected.
+//
+// Objects in actual may contain more fields than objects in expected.
+// Arrays must have the same length and their elements are matched in order,
+// following the same rule. Other values must be equal.
+//
+// This is useful to check the relevant part of an API response.
+//
+// Expected and actual must be valid JSON.
+//
+// For dynamic redaction of the input text via a callback, use [JSONMatchesT].
+//
+// # Usage
+//
+//	assertions.JSONMatches(t, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+//
+// # Examples
+//
+//	success: `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`
+//	failure: `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func JSONMatches(t T, expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatches(t, expected, actual, msgAndArgs...)
+}
+
+// JSONMatchesT asserts that a JSON document matches the expected JSON document,
+// ignoring any field in actual that is not present in expected.
+//
+// See [JSONMatches] for the matching rules.
+//
+// The expected and actual arguments may be string or []byte (e.g. [json.RawMessage]).
+// They do not need to be of the same type.
+//
+// NOTE: passed values (expected, actual) may be wrapped as functions to redact the input text dynamically.
+//
+// # Usage
+//
+//	assertions.JSONMatchesT(t, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+//
+// # Examples
+//
+//	success: `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`)
+//	failure: `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`)
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func JSONMatchesT[EDoc, ADoc RText](t T, expected EDoc, actual ADoc, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatchesT[EDoc, ADoc](t, expected, actual, msgAndArgs...)
+}
+
 // JSONUnmarshalAsT wraps [Equal] after [json.Unmarshal].
 //
 // The input JSON may be a string or []byte.
@@ -1958,6 +2389,27 @@ func MapEqualT[K, V comparable](t T, listA map[K]V, listB map[K]V, msgAndArgs ..
 	return assertions.MapEqualT[K, V](t, listA, listB, msgAndArgs...)
 }
 
+// MapLenT asserts that the specified map has a specific number of keys.
+//
+// Unlike [Len], the length is obtained without reflection.
+//
+// # Usage
+//
+//	assertions.MapLenT(t, map[string]string{"Hello": "x","World": "y"}, 2)
+//
+// # Examples
+//
+//	success: map[string]string{"A": "B"}, 1
+//	failure: map[string]string{"A": "B"}, 2
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func MapLenT[Map ~map[K]V, K comparable, V any](t T, m Map, length int, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.MapLenT[Map, K, V](t, m, length, msgAndArgs...)
+}
+
 // MapNotContainsT asserts that the specified map does not contain a key.
 //
 // # Usage
@@ -2000,6 +2452,40 @@ func MapNotEqualT[K, V comparable](t T, listA map[K]V, listB map[K]V, msgAndArgs
 	return assertions.MapNotEqualT[K, V](t, listA, listB, msgAndArgs...)
 }
 
+// MaxAllocsPerRun asserts that the function allocates at most maxAllocs times per run.
+//
+// The number of allocations is averaged over 100 runs with [testing.AllocsPerRun],
+// after a warm-up run.
+//
+// The measured and maximum numbers of allocations are reported as the actual and expected values
+// of the [Failure] passed to [FailureReporter] hooks.
+//
+// # Concurrency
+//
+// [testing.AllocsPerRun] sets GOMAXPROCS to 1 while measuring: [MaxAllocsPerRun] cannot be
+// used while parallel tests are running, and fails in that case.
+//
+// Allocations are not reliably measured when running with the race detector.
+//
+// # Usage
+//
+//	assertions.MaxAllocsPerRun(t, 0, func() {
+//		_ = strconv.Itoa(42)
+//	})
+//
+// # Examples
+//
+//	success: 0, func() {}
+//	failure: 0, func() { _ = make([]byte, 1<<20) }
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func MaxAllocsPerRun(t T, maxAllocs int, fn func(), msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.MaxAllocsPerRun(t, maxAllocs, fn, msgAndArgs...)
+}
+
 // Negative asserts that the specified element is strictly negative.
 //
 // # Usage
@@ -2717,6 +3203,32 @@ func Panics(t T, f func(), msgAndArgs ...any) bool {
 	return assertions.Panics(t, f, msgAndArgs...)
 }
 
+// PanicsMatching asserts that the code inside the specified function panics,
+// and that the recovered panic value satisfies the matcher function.
+//
+// This is useful to check panics carrying structured values, without
+// re-implementing the recover logic.
+//
+// # Usage
+//
+//	assertions.PanicsMatching(t, func(v any) bool {
+//		e, ok := v.(*MyPanic)
+//		return ok && e.Code == 42
+//	}, func(){ GoCrazy() })
+//
+// # Examples
+//
+//	success: func(v any) bool { return v == "panicking" }, func() { panic("panicking") }
+//	failure: func(v any) bool { return v == "panicking" }, func() { panic("other") }
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func PanicsMatching(t T, matcher func(any) bool, f func(), msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsMatching(t, matcher, f, msgAndArgs...)
+}
+
 // PanicsWithError asserts that the code inside the specified function panics,
 // and that the recovered panic value is an error that satisfies the [EqualError] comparison.
 //
@@ -2737,6 +3249,28 @@ func PanicsWithError(t T, errString string, f func(), msgAndArgs ...any) bool {
 	return assertions.PanicsWithError(t, errString, f, msgAndArgs...)
 }
 
+// PanicsWithErrorIs asserts that the code inside the specified function panics,
+// and that the recovered panic value is an error that satisfies the [ErrorIs] comparison.
+//
+// This is useful when the panic value wraps a sentinel error.
+//
+// # Usage
+//
+//	assertions.PanicsWithErrorIs(t, ErrCrazy, func(){ GoCrazy() })
+//
+// # Examples
+//
+//	success: ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }
+//	failure: ErrTest, func() { }
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func PanicsWithErrorIs(t T, target error, f func(), msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsWithErrorIs(t, target, f, msgAndArgs...)
+}
+
 // PanicsWithValue asserts that the code inside the specified function panics,
 // and that the recovered panic value equals the expected panic value.
 //
@@ -2797,6 +3331,69 @@ func PositiveT[SignedNumber SignedNumeric](t T, e SignedNumber, msgAndArgs ...an
 	return assertions.PositiveT[SignedNumber](t, e, msgAndArgs...)
 }
 
+// ProtoEqual asserts that two protobuf messages are equal, using the semantics of [proto.Equal].
+//
+// Unlike [Equal], the internal state of generated protobuf structs (e.g. caches, locks)
+// is not compared. Unknown fields are compared: see [ProtoEqualIgnoringUnknown] to ignore them.
+//
+// Expected and actual must be protobuf messages (i.e. implement [proto.Message]).
+//
+// # Important
+//
+// By default, this function is disabled and will panic.
+//
+// To enable it, you should add a blank import like so:
+//
+//	import(
+//	  _ "github.com/go-openapi/testify/enable/proto/v2"
+//	)
+//
+// # Usage
+//
+//	assertions.ProtoEqual(t, &pb.User{Name: "x"}, got)
+//
+// # Examples
+//
+//	panic: "a", "a"
+//	should panic without the proto feature enabled.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+//
+// [proto.Equal]: https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+// [proto.Message]: https://pkg.go.dev/google.golang.org/protobuf/proto#Message
+func ProtoEqual(t T, expected any, actual any, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqual(t, expected, actual, msgAndArgs...)
+}
+
+// ProtoEqualIgnoringUnknown asserts that two protobuf messages are equal, using the semantics of [proto.Equal]
+// but ignoring unknown fields, at any nesting level.
+//
+// This is useful when comparing messages decoded from a peer using a more recent schema.
+//
+// See [ProtoEqual].
+//
+// # Usage
+//
+//	assertions.ProtoEqualIgnoringUnknown(t, &pb.User{Name: "x"}, got)
+//
+// # Examples
+//
+//	panic: "a", "a"
+//	should panic without the proto feature enabled.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+//
+// [proto.Equal]: https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func ProtoEqualIgnoringUnknown(t T, expected any, actual any, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqualIgnoringUnknown(t, expected, actual, msgAndArgs...)
+}
+
 // Regexp asserts that a specified regular expression matches a string.
 //
 // The regular expression may be passed as a [regexp.Regexp], a string or a []byte and will be compiled.
@@ -2911,6 +3508,28 @@ func SeqContainsT[E comparable](t T, iter iter.Seq[E], element E, msgAndArgs ...
 	return assertions.SeqContainsT[E](t, iter, element, msgAndArgs...)
 }
 
+// SeqLenT asserts that the specified iterator yields a specific number of elements.
+//
+// The sequence is consumed entirely, unless it yields more elements than expected:
+// in that case, the iteration stops as soon as the expected length is exceeded.
+//
+// # Usage
+//
+//	assertions.SeqLenT(t, slices.Values([]string{"Hello","World"}), 2)
+//
+// # Examples
+//
+//	success: slices.Values([]string{"A","B"}), 2
+//	failure: slices.Values([]string{"A","B"}), 1
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func SeqLenT[E any](t T, iter iter.Seq[E], length int, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.SeqLenT[E](t, iter, length, msgAndArgs...)
+}
+
 // SeqNotContainsT asserts that the specified iterator does not contain a comparable element.
 //
 // See [SeqContainsT].
@@ -2979,6 +3598,27 @@ func SliceEqualT[E comparable](t T, listA []E, listB []E, msgAndArgs ...any) (ok
 	return assertions.SliceEqualT[E](t, listA, listB, msgAndArgs...)
 }
 
+// SliceLenT asserts that the specified slice has a specific length.
+//
+// Unlike [Len], the length is obtained without reflection.
+//
+// # Usage
+//
+//	assertions.SliceLenT(t, []string{"Hello","World"}, 2)
+//
+// # Examples
+//
+//	success: []string{"A","B"}, 2
+//	failure: []string{"A","B"}, 1
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func SliceLenT[Slice ~[]E, E any](t T, s Slice, length int, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.SliceLenT[Slice, E](t, s, length, msgAndArgs...)
+}
+
 // SliceNotContainsT asserts that the specified slice does not contain a comparable element.
 //
 // See [SliceContainsT].
@@ -3263,7 +3903,7 @@ func YAMLEq(t T, expected string, actual string, msgAndArgs ...any) bool {
 // To enable it, you should add a blank import like so:
 //
 //	import(
-//	  "github.com/go-openapi/testify/enable/yaml/v2"
+//	  _ "github.com/go-openapi/testify/enable/yaml/v2"
 //	)
 //
 // For dynamic redaction of the input text via a callback, use [YAMLEqT].
@@ -6,10 +6,13 @@
 package assert
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"slices"
@@ -71,6 +74,33 @@ func TestBlockedT(t *testing.T) {
 	})
 }
 
+func TestCompletesWithin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := CompletesWithin(mock, time.Second, func() {})
+		if !result {
+			t.Error("CompletesWithin should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := CompletesWithin(mock, 10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })
+		if result {
+			t.Error("CompletesWithin should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("CompletesWithin should mark test as failed")
+		}
+	})
+}
+
 func TestCondition(t *testing.T) {
 	t.Parallel()
 
@@ -152,6 +182,33 @@ func TestContains(t *testing.T) {
 	})
 }
 
+func TestDirEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := DirEqual(mock, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+		if !result {
+			t.Error("DirEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := DirEqual(mock, testDataPath(), filepath.Join(testDataPath(), "existing_dir"))
+		if result {
+			t.Error("DirEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("DirEqual should mark test as failed")
+		}
+	})
+}
+
 func TestDirExists(t *testing.T) {
 	t.Parallel()
 
@@ -368,6 +425,33 @@ func TestEqualExportedValues(t *testing.T) {
 	})
 }
 
+func TestEqualIgnoringFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EqualIgnoringFields(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+		if !result {
+			t.Error("EqualIgnoringFields should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EqualIgnoringFields(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"})
+		if result {
+			t.Error("EqualIgnoringFields should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("EqualIgnoringFields should mark test as failed")
+		}
+	})
+}
+
 func TestEqualT(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +560,33 @@ func TestErrorAs(t *testing.T) {
 	})
 }
 
+func TestErrorAsType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		_, result := ErrorAsType[*dummyError](mock, fmt.Errorf("wrap: %w", &dummyError{}))
+		if !result {
+			t.Error("ErrorAsType should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		_, result := ErrorAsType[*dummyError](mock, ErrTest)
+		if result {
+			t.Error("ErrorAsType should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("ErrorAsType should mark test as failed")
+		}
+	})
+}
+
 func TestErrorContains(t *testing.T) {
 	t.Parallel()
 
@@ -597,6 +708,33 @@ func TestEventuallyWith(t *testing.T) {
 	})
 }
 
+func TestEventuallyWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EventuallyWithContext(mock, context.Background(), func() bool { return true }, 20*time.Millisecond)
+		if !result {
+			t.Error("EventuallyWithContext should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EventuallyWithContext(mock, func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond)
+		if result {
+			t.Error("EventuallyWithContext should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("EventuallyWithContext should mark test as failed")
+		}
+	})
+}
+
 func TestExactly(t *testing.T) {
 	t.Parallel()
 
@@ -624,6 +762,33 @@ func TestExactly(t *testing.T) {
 	})
 }
 
+func TestFSEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FSEqual(mock, os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+		if !result {
+			t.Error("FSEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FSEqual(mock, os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")))
+		if result {
+			t.Error("FSEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FSEqual should mark test as failed")
+		}
+	})
+}
+
 func TestFail(t *testing.T) {
 	t.Parallel()
 
@@ -739,6 +904,60 @@ func TestFileEmpty(t *testing.T) {
 	})
 }
 
+func TestFileEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqual(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+		if !result {
+			t.Error("FileEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqual(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"))
+		if result {
+			t.Error("FileEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FileEqual should mark test as failed")
+		}
+	})
+}
+
+func TestFileEqualBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualBytes(mock, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		if !result {
+			t.Error("FileEqualBytes should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualBytes(mock, []byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		if result {
+			t.Error("FileEqualBytes should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FileEqualBytes should mark test as failed")
+		}
+	})
+}
+
 func TestFileExists(t *testing.T) {
 	t.Parallel()
 
@@ -928,6 +1147,33 @@ func TestGreaterT(t *testing.T) {
 	})
 }
 
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Group(mock, func(c *CollectT) { True(c, true); Equal(c, 1, 1) })
+		if !result {
+			t.Error("Group should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Group(mock, func(c *CollectT) { True(c, false); Equal(c, 1, 2) })
+		if result {
+			t.Error("Group should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Group should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPBodyContains(t *testing.T) {
 	t.Parallel()
 
@@ -955,6 +1201,33 @@ func TestHTTPBodyContains(t *testing.T) {
 	})
 }
 
+func TestHTTPBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPBodyJSONEq(mock, httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+		if !result {
+			t.Error("HTTPBodyJSONEq should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPBodyJSONEq(mock, httpJSON, "GET", "/", nil, `{"hello": "bob"}`)
+		if result {
+			t.Error("HTTPBodyJSONEq should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPBodyJSONEq should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPBodyNotContains(t *testing.T) {
 	t.Parallel()
 
@@ -1009,6 +1282,33 @@ func TestHTTPError(t *testing.T) {
 	})
 }
 
+func TestHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPHeader(mock, httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+		if !result {
+			t.Error("HTTPHeader should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPHeader(mock, httpJSON, "GET", "/", nil, "Content-Type", "text/plain")
+		if result {
+			t.Error("HTTPHeader should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPHeader should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPRedirect(t *testing.T) {
 	t.Parallel()
 
@@ -1036,6 +1336,60 @@ func TestHTTPRedirect(t *testing.T) {
 	})
 }
 
+func TestHTTPRoundTripBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripBodyJSONEq(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+		if !result {
+			t.Error("HTTPRoundTripBodyJSONEq should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripBodyJSONEq(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`)
+		if result {
+			t.Error("HTTPRoundTripBodyJSONEq should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPRoundTripBodyJSONEq should mark test as failed")
+		}
+	})
+}
+
+func TestHTTPRoundTripStatusCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripStatusCode(mock, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		if !result {
+			t.Error("HTTPRoundTripStatusCode should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripStatusCode(mock, httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		if result {
+			t.Error("HTTPRoundTripStatusCode should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPRoundTripStatusCode should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPStatusCode(t *testing.T) {
 	t.Parallel()
 
@@ -1792,6 +2146,60 @@ func TestJSONMarshalAsT(t *testing.T) {
 	})
 }
 
+func TestJSONMatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatches(mock, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+		if !result {
+			t.Error("JSONMatches should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatches(mock, `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`)
+		if result {
+			t.Error("JSONMatches should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("JSONMatches should mark test as failed")
+		}
+	})
+}
+
+func TestJSONMatchesT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesT(mock, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+		if !result {
+			t.Error("JSONMatchesT should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesT(mock, `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`))
+		if result {
+			t.Error("JSONMatchesT should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("JSONMatchesT should mark test as failed")
+		}
+	})
+}
+
 func TestJSONUnmarshalAsT(t *testing.T) {
 	t.Parallel()
 
@@ -2035,6 +2443,33 @@ func TestMapEqualT(t *testing.T) {
 	})
 }
 
+func TestMapLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := MapLenT(mock, map[string]string{"A": "B"}, 1)
+		if !result {
+			t.Error("MapLenT should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := MapLenT(mock, map[string]string{"A": "B"}, 2)
+		if result {
+			t.Error("MapLenT should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("MapLenT should mark test as failed")
+		}
+	})
+}
+
 func TestMapNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -2089,6 +2524,27 @@ func TestMapNotEqualT(t *testing.T) {
 	})
 }
 
+func TestMaxAllocsPerRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockT)
+		result := MaxAllocsPerRun(mock, 0, func() {})
+		if !result {
+			t.Error("MaxAllocsPerRun should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockT)
+		result := MaxAllocsPerRun(mock, 0, func() { _ = make([]byte, 1<<20) })
+		if result {
+			t.Error("MaxAllocsPerRun should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("MaxAllocsPerRun should mark test as failed")
+		}
+	})
+}
+
 func TestNegative(t *testing.T) {
 	t.Parallel()
 
@@ -2873,6 +3329,33 @@ func TestPanics(t *testing.T) {
 	})
 }
 
+func TestPanicsMatching(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsMatching(mock, func(v any) bool { return v == "panicking" }, func() { panic("panicking") })
+		if !result {
+			t.Error("PanicsMatching should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsMatching(mock, func(v any) bool { return v == "panicking" }, func() { panic("other") })
+		if result {
+			t.Error("PanicsMatching should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("PanicsMatching should mark test as failed")
+		}
+	})
+}
+
 func TestPanicsWithError(t *testing.T) {
 	t.Parallel()
 
@@ -2900,6 +3383,33 @@ func TestPanicsWithError(t *testing.T) {
 	})
 }
 
+func TestPanicsWithErrorIs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsWithErrorIs(mock, ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) })
+		if !result {
+			t.Error("PanicsWithErrorIs should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsWithErrorIs(mock, ErrTest, func() {})
+		if result {
+			t.Error("PanicsWithErrorIs should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("PanicsWithErrorIs should mark test as failed")
+		}
+	})
+}
+
 func TestPanicsWithValue(t *testing.T) {
 	t.Parallel()
 
@@ -2981,6 +3491,44 @@ func TestPositiveT(t *testing.T) {
 	})
 }
 
+func TestProtoEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Panics(t, func() {
+			ProtoEqual(mock, "a", "a")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("ProtoEqual should return true on panic")
+		}
+		if mock.failed {
+			t.Error("ProtoEqual should panic as expected")
+		}
+	})
+}
+
+func TestProtoEqualIgnoringUnknown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Panics(t, func() {
+			ProtoEqualIgnoringUnknown(mock, "a", "a")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("ProtoEqualIgnoringUnknown should return true on panic")
+		}
+		if mock.failed {
+			t.Error("ProtoEqualIgnoringUnknown should panic as expected")
+		}
+	})
+}
+
 func TestRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -3116,6 +3664,33 @@ func TestSeqContainsT(t *testing.T) {
 	})
 }
 
+func TestSeqLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SeqLenT(mock, slices.Values([]string{"A", "B"}), 2)
+		if !result {
+			t.Error("SeqLenT should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SeqLenT(mock, slices.Values([]string{"A", "B"}), 1)
+		if result {
+			t.Error("SeqLenT should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("SeqLenT should mark test as failed")
+		}
+	})
+}
+
 func TestSeqNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -3197,6 +3772,33 @@ func TestSliceEqualT(t *testing.T) {
 	})
 }
 
+func TestSliceLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SliceLenT(mock, []string{"A", "B"}, 2)
+		if !result {
+			t.Error("SliceLenT should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SliceLenT(mock, []string{"A", "B"}, 1)
+		if result {
+			t.Error("SliceLenT should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("SliceLenT should mark test as failed")
+		}
+	})
+}
+
 func TestSliceNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -3691,6 +4293,21 @@ func httpBody(w http.ResponseWriter, r *http.Request) {
 	_, _ = fmt.Fprintf(w, "Hello, %s!", name)
 }
 
+func httpJSON(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"hello": "world"}`)
+}
+
+// httpRoundTripper serves requests with a handler, in place of a client transport.
+type httpRoundTripper http.HandlerFunc
+
+func (f httpRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	f(w, r)
+
+	return w.Result(), nil
+}
+
 func sendChanMessage() chan struct{} {
 	ch := make(chan struct{}, 1)
 	ch <- struct{}{}
@@ -6,10 +6,13 @@
 package assert_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -36,6 +39,15 @@ func ExampleBlockedT() {
 	// Output: success: true
 }
 
+func ExampleCompletesWithin() {
+	t := new(testing.T) // should come from testing, e.g. func TestCompletesWithin(t *testing.T)
+	success := assert.CompletesWithin(t, time.Second, func() {
+	})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleCondition() {
 	t := new(testing.T) // should come from testing, e.g. func TestCondition(t *testing.T)
 	success := assert.Condition(t, func() bool {
@@ -64,6 +76,14 @@ func ExampleContains() {
 	// Output: success: true
 }
 
+func ExampleDirEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestDirEqual(t *testing.T)
+	success := assert.DirEqual(t, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleDirExists() {
 	t := new(testing.T) // should come from testing, e.g. func TestDirExists(t *testing.T)
 	success := assert.DirExists(t, filepath.Join(testDataPath(), "existing_dir"))
@@ -128,6 +148,14 @@ func ExampleEqualExportedValues() {
 	// Output: success: true
 }
 
+func ExampleEqualIgnoringFields() {
+	t := new(testing.T) // should come from testing, e.g. func TestEqualIgnoringFields(t *testing.T)
+	success := assert.EqualIgnoringFields(t, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleEqualT() {
 	t := new(testing.T) // should come from testing, e.g. func TestEqualT(t *testing.T)
 	success := assert.EqualT(t, 123, 123)
@@ -160,6 +188,14 @@ func ExampleErrorAs() {
 	// Output: success: true
 }
 
+func ExampleErrorAsType() {
+	t := new(testing.T) // should come from testing, e.g. func TestErrorAsType(t *testing.T)
+	_, success := assert.ErrorAsType[*dummyError](t, fmt.Errorf("wrap: %w", &dummyError{}))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleErrorContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestErrorContains(t *testing.T)
 	success := assert.ErrorContains(t, assert.ErrTest, "general error")
@@ -196,6 +232,16 @@ func ExampleEventuallyWith() {
 	// Output: success: true
 }
 
+func ExampleEventuallyWithContext() {
+	t := new(testing.T) // should come from testing, e.g. func TestEventuallyWithContext(t *testing.T)
+	success := assert.EventuallyWithContext(t, context.Background(), func() bool {
+		return true
+	}, 20*time.Millisecond)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleExactly() {
 	t := new(testing.T) // should come from testing, e.g. func TestExactly(t *testing.T)
 	success := assert.Exactly(t, int32(123), int32(123))
@@ -204,6 +250,14 @@ func ExampleExactly() {
 	// Output: success: true
 }
 
+func ExampleFSEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestFSEqual(t *testing.T)
+	success := assert.FSEqual(t, os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 // func ExampleFail() {
 // no success example available. Please add some examples to produce a testable example.
 // }
@@ -236,6 +290,22 @@ func ExampleFileEmpty() {
 	// Output: success: true
 }
 
+func ExampleFileEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestFileEqual(t *testing.T)
+	success := assert.FileEqual(t, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
+func ExampleFileEqualBytes() {
+	t := new(testing.T) // should come from testing, e.g. func TestFileEqualBytes(t *testing.T)
+	success := assert.FileEqualBytes(t, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleFileExists() {
 	t := new(testing.T) // should come from testing, e.g. func TestFileExists(t *testing.T)
 	success := assert.FileExists(t, filepath.Join(testDataPath(), "existing_file"))
@@ -292,6 +362,17 @@ func ExampleGreaterT() {
 	// Output: success: true
 }
 
+func ExampleGroup() {
+	t := new(testing.T) // should come from testing, e.g. func TestGroup(t *testing.T)
+	success := assert.Group(t, func(c *assert.CollectT) {
+		assert.True(c, true)
+		assert.Equal(c, 1, 1)
+	})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleHTTPBodyContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyContains(t *testing.T)
 	success := assert.HTTPBodyContains(t, httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, World!")
@@ -300,6 +381,14 @@ func ExampleHTTPBodyContains() {
 	// Output: success: true
 }
 
+func ExampleHTTPBodyJSONEq() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyJSONEq(t *testing.T)
+	success := assert.HTTPBodyJSONEq(t, httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleHTTPBodyNotContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyNotContains(t *testing.T)
 	success := assert.HTTPBodyNotContains(t, httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, Bob!")
@@ -316,6 +405,14 @@ func ExampleHTTPError() {
 	// Output: success: true
 }
 
+func ExampleHTTPHeader() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPHeader(t *testing.T)
+	success := assert.HTTPHeader(t, httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleHTTPRedirect() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPRedirect(t *testing.T)
 	success := assert.HTTPRedirect(t, httpRedirect, "GET", "/", nil)
@@ -324,6 +421,22 @@ func ExampleHTTPRedirect() {
 	// Output: success: true
 }
 
+func ExampleHTTPRoundTripBodyJSONEq() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPRoundTripBodyJSONEq(t *testing.T)
+	success := assert.HTTPRoundTripBodyJSONEq(t, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
+func ExampleHTTPRoundTripStatusCode() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPRoundTripStatusCode(t *testing.T)
+	success := assert.HTTPRoundTripStatusCode(t, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleHTTPStatusCode() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPStatusCode(t *testing.T)
 	success := assert.HTTPStatusCode(t, httpOK, "GET", "/", nil, http.StatusOK)
@@ -548,6 +661,22 @@ func ExampleJSONMarshalAsT() {
 	// Output: success: true
 }
 
+func ExampleJSONMatches() {
+	t := new(testing.T) // should come from testing, e.g. func TestJSONMatches(t *testing.T)
+	success := assert.JSONMatches(t, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
+func ExampleJSONMatchesT() {
+	t := new(testing.T) // should come from testing, e.g. func TestJSONMatchesT(t *testing.T)
+	success := assert.JSONMatchesT(t, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleJSONUnmarshalAsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestJSONUnmarshalAsT(t *testing.T)
 	success := assert.JSONUnmarshalAsT(t, dummyStruct{A: "a"}, []byte(`{"A": "a"}`))
@@ -620,6 +749,14 @@ func ExampleMapEqualT() {
 	// Output: success: true
 }
 
+func ExampleMapLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestMapLenT(t *testing.T)
+	success := assert.MapLenT(t, map[string]string{"A": "B"}, 1)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleMapNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestMapNotContainsT(t *testing.T)
 	success := assert.MapNotContainsT(t, map[string]string{"A": "B"}, "C")
@@ -636,6 +773,15 @@ func ExampleMapNotEqualT() {
 	// Output: success: true
 }
 
+func ExampleMaxAllocsPerRun() {
+	t := new(testing.T) // should come from testing, e.g. func TestMaxAllocsPerRun(t *testing.T)
+	success := assert.MaxAllocsPerRun(t, 0, func() {
+	})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleNegative() {
 	t := new(testing.T) // should come from testing, e.g. func TestNegative(t *testing.T)
 	success := assert.Negative(t, -1)
@@ -891,6 +1037,18 @@ func ExamplePanics() {
 	// Output: success: true
 }
 
+func ExamplePanicsMatching() {
+	t := new(testing.T) // should come from testing, e.g. func TestPanicsMatching(t *testing.T)
+	success := assert.PanicsMatching(t, func(v any) bool {
+		return v == "panicking"
+	}, func() {
+		panic("panicking")
+	})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExamplePanicsWithError() {
 	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithError(t *testing.T)
 	success := assert.PanicsWithError(t, assert.ErrTest.Error(), func() {
@@ -901,6 +1059,16 @@ func ExamplePanicsWithError() {
 	// Output: success: true
 }
 
+func ExamplePanicsWithErrorIs() {
+	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithErrorIs(t *testing.T)
+	success := assert.PanicsWithErrorIs(t, assert.ErrTest, func() {
+		panic(fmt.Errorf("wrap: %w", assert.ErrTest))
+	})
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExamplePanicsWithValue() {
 	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithValue(t *testing.T)
 	success := assert.PanicsWithValue(t, "panicking", func() {
@@ -927,6 +1095,14 @@ func ExamplePositiveT() {
 	// Output: success: true
 }
 
+// func ExampleProtoEqual() {
+// no success example available. Please add some examples to produce a testable example.
+// }
+
+// func ExampleProtoEqualIgnoringUnknown() {
+// no success example available. Please add some examples to produce a testable example.
+// }
+
 func ExampleRegexp() {
 	t := new(testing.T) // should come from testing, e.g. func TestRegexp(t *testing.T)
 	success := assert.Regexp(t, "^start", "starting")
@@ -967,6 +1143,14 @@ func ExampleSeqContainsT() {
 	// Output: success: true
 }
 
+func ExampleSeqLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestSeqLenT(t *testing.T)
+	success := assert.SeqLenT(t, slices.Values([]string{"A", "B"}), 2)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleSeqNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestSeqNotContainsT(t *testing.T)
 	success := assert.SeqNotContainsT(t, slices.Values([]string{"A", "B"}), "C")
@@ -991,6 +1175,14 @@ func ExampleSliceEqualT() {
 	// Output: success: true
 }
 
+func ExampleSliceLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestSliceLenT(t *testing.T)
+	success := assert.SliceLenT(t, []string{"A", "B"}, 2)
+	fmt.Printf("success: %t\n", success)
+
+	// Output: success: true
+}
+
 func ExampleSliceNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestSliceNotContainsT(t *testing.T)
 	success := assert.SliceNotContainsT(t, []string{"A", "B"}, "C")
@@ -1140,6 +1332,21 @@ func httpBody(w http.ResponseWriter, r *http.Request) {
 	_, _ = fmt.Fprintf(w, "Hello, %s!", name)
 }
 
+func httpJSON(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"hello": "world"}`)
+}
+
+// httpRoundTripper serves requests with a handler, in place of a client transport.
+type httpRoundTripper http.HandlerFunc
+
+func (f httpRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	f(w, r)
+
+	return w.Result(), nil
+}
+
 func sendChanMessage() chan struct{} {
 	ch := make(chan struct{}, 1)
 	ch <- struct{}{}
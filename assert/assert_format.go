@@ -6,6 +6,8 @@
 package assert
 
 import (
+	"context"
+	"io/fs"
 	"iter"
 	"net/http"
 	"net/url"
@@ -35,6 +37,16 @@ func BlockedTf[E any, CHAN ~chan E](t T, ch CHAN, msg string, args ...any) bool
 	return assertions.BlockedT[E, CHAN](t, ch, forwardArgs(msg, args)...)
 }
 
+// CompletesWithinf is the same as [CompletesWithin], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func CompletesWithinf(t T, d time.Duration, fn func(), msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.CompletesWithin(t, d, fn, forwardArgs(msg, args)...)
+}
+
 // Conditionf is the same as [Condition], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -65,6 +77,16 @@ func Containsf(t T, s any, contains any, msg string, args ...any) bool {
 	return assertions.Contains(t, s, contains, forwardArgs(msg, args)...)
 }
 
+// DirEqualf is the same as [DirEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func DirEqualf(t T, expected string, actual string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.DirEqual(t, expected, actual, forwardArgs(msg, args)...)
+}
+
 // DirExistsf is the same as [DirExists], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -145,6 +167,16 @@ func EqualExportedValuesf(t T, expected any, actual any, msg string, args ...any
 	return assertions.EqualExportedValues(t, expected, actual, forwardArgs(msg, args)...)
 }
 
+// EqualIgnoringFieldsf is the same as [EqualIgnoringFields], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func EqualIgnoringFieldsf(t T, expected any, actual any, fields []string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.EqualIgnoringFields(t, expected, actual, fields, forwardArgs(msg, args)...)
+}
+
 // EqualTf is the same as [EqualT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -185,6 +217,16 @@ func ErrorAsf(t T, err error, target any, msg string, args ...any) bool {
 	return assertions.ErrorAs(t, err, target, forwardArgs(msg, args)...)
 }
 
+// ErrorAsTypef is the same as [ErrorAsType], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func ErrorAsTypef[E error](t T, err error, msg string, args ...any) (E, bool) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ErrorAsType[E](t, err, forwardArgs(msg, args)...)
+}
+
 // ErrorContainsf is the same as [ErrorContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -225,6 +267,16 @@ func EventuallyWithf[C CollectibleConditioner](t T, condition C, timeout time.Du
 	return assertions.EventuallyWith[C](t, condition, timeout, tick, forwardArgs(msg, args)...)
 }
 
+// EventuallyWithContextf is the same as [EventuallyWithContext], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func EventuallyWithContextf[C Conditioner](t T, ctx context.Context, condition C, tick time.Duration, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.EventuallyWithContext[C](t, ctx, condition, tick, forwardArgs(msg, args)...)
+}
+
 // Exactlyf is the same as [Exactly], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -235,6 +287,16 @@ func Exactlyf(t T, expected any, actual any, msg string, args ...any) bool {
 	return assertions.Exactly(t, expected, actual, forwardArgs(msg, args)...)
 }
 
+// FSEqualf is the same as [FSEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FSEqualf(t T, expected fs.FS, actual fs.FS, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FSEqual(t, expected, actual, forwardArgs(msg, args)...)
+}
+
 // Failf is the same as [Fail], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -285,6 +347,26 @@ func FileEmptyf(t T, path string, msg string, args ...any) bool {
 	return assertions.FileEmpty(t, path, forwardArgs(msg, args)...)
 }
 
+// FileEqualf is the same as [FileEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FileEqualf(t T, expected string, actual string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqual(t, expected, actual, forwardArgs(msg, args)...)
+}
+
+// FileEqualBytesf is the same as [FileEqualBytes], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func FileEqualBytesf(t T, expected []byte, actual string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqualBytes(t, expected, actual, forwardArgs(msg, args)...)
+}
+
 // FileExistsf is the same as [FileExists], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -355,6 +437,16 @@ func GreaterTf[Orderable Ordered](t T, e1 Orderable, e2 Orderable, msg string, a
 	return assertions.GreaterT[Orderable](t, e1, e2, forwardArgs(msg, args)...)
 }
 
+// Groupf is the same as [Group], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func Groupf(t T, block func(*CollectT), msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.Group(t, block, forwardArgs(msg, args)...)
+}
+
 // HTTPBodyContainsf is the same as [HTTPBodyContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -365,6 +457,16 @@ func HTTPBodyContainsf(t T, handler http.HandlerFunc, method string, url string,
 	return assertions.HTTPBodyContains(t, handler, method, url, values, str, forwardArgs(msg, args)...)
 }
 
+// HTTPBodyJSONEqf is the same as [HTTPBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPBodyJSONEqf(t T, handler http.HandlerFunc, method string, url string, values url.Values, expected string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPBodyJSONEq(t, handler, method, url, values, expected, forwardArgs(msg, args)...)
+}
+
 // HTTPBodyNotContainsf is the same as [HTTPBodyNotContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -385,6 +487,16 @@ func HTTPErrorf(t T, handler http.HandlerFunc, method string, url string, values
 	return assertions.HTTPError(t, handler, method, url, values, forwardArgs(msg, args)...)
 }
 
+// HTTPHeaderf is the same as [HTTPHeader], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPHeaderf(t T, handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPHeader(t, handler, method, url, values, header, expected, forwardArgs(msg, args)...)
+}
+
 // HTTPRedirectf is the same as [HTTPRedirect], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -395,6 +507,26 @@ func HTTPRedirectf(t T, handler http.HandlerFunc, method string, url string, val
 	return assertions.HTTPRedirect(t, handler, method, url, values, forwardArgs(msg, args)...)
 }
 
+// HTTPRoundTripBodyJSONEqf is the same as [HTTPRoundTripBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPRoundTripBodyJSONEqf(t T, rt http.RoundTripper, req *http.Request, expected string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripBodyJSONEq(t, rt, req, expected, forwardArgs(msg, args)...)
+}
+
+// HTTPRoundTripStatusCodef is the same as [HTTPRoundTripStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func HTTPRoundTripStatusCodef(t T, rt http.RoundTripper, req *http.Request, statuscode int, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripStatusCode(t, rt, req, statuscode, forwardArgs(msg, args)...)
+}
+
 // HTTPStatusCodef is the same as [HTTPStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -675,6 +807,26 @@ func JSONMarshalAsTf[EDoc RText](t T, expected EDoc, object any, msg string, arg
 	return assertions.JSONMarshalAsT[EDoc](t, expected, object, forwardArgs(msg, args)...)
 }
 
+// JSONMatchesf is the same as [JSONMatches], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func JSONMatchesf(t T, expected string, actual string, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatches(t, expected, actual, forwardArgs(msg, args)...)
+}
+
+// JSONMatchesTf is the same as [JSONMatchesT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func JSONMatchesTf[EDoc, ADoc RText](t T, expected EDoc, actual ADoc, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatchesT[EDoc, ADoc](t, expected, actual, forwardArgs(msg, args)...)
+}
+
 // JSONUnmarshalAsTf is the same as [JSONUnmarshalAsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -765,6 +917,16 @@ func MapEqualTf[K, V comparable](t T, listA map[K]V, listB map[K]V, msg string,
 	return assertions.MapEqualT[K, V](t, listA, listB, forwardArgs(msg, args)...)
 }
 
+// MapLenTf is the same as [MapLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func MapLenTf[Map ~map[K]V, K comparable, V any](t T, m Map, length int, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.MapLenT[Map, K, V](t, m, length, forwardArgs(msg, args)...)
+}
+
 // MapNotContainsTf is the same as [MapNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -785,6 +947,16 @@ func MapNotEqualTf[K, V comparable](t T, listA map[K]V, listB map[K]V, msg strin
 	return assertions.MapNotEqualT[K, V](t, listA, listB, forwardArgs(msg, args)...)
 }
 
+// MaxAllocsPerRunf is the same as [MaxAllocsPerRun], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func MaxAllocsPerRunf(t T, maxAllocs int, fn func(), msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.MaxAllocsPerRun(t, maxAllocs, fn, forwardArgs(msg, args)...)
+}
+
 // Negativef is the same as [Negative], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1085,6 +1257,16 @@ func Panicsf(t T, f func(), msg string, args ...any) bool {
 	return assertions.Panics(t, f, forwardArgs(msg, args)...)
 }
 
+// PanicsMatchingf is the same as [PanicsMatching], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func PanicsMatchingf(t T, matcher func(any) bool, f func(), msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsMatching(t, matcher, f, forwardArgs(msg, args)...)
+}
+
 // PanicsWithErrorf is the same as [PanicsWithError], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1095,6 +1277,16 @@ func PanicsWithErrorf(t T, errString string, f func(), msg string, args ...any)
 	return assertions.PanicsWithError(t, errString, f, forwardArgs(msg, args)...)
 }
 
+// PanicsWithErrorIsf is the same as [PanicsWithErrorIs], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func PanicsWithErrorIsf(t T, target error, f func(), msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsWithErrorIs(t, target, f, forwardArgs(msg, args)...)
+}
+
 // PanicsWithValuef is the same as [PanicsWithValue], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1125,6 +1317,26 @@ func PositiveTf[SignedNumber SignedNumeric](t T, e SignedNumber, msg string, arg
 	return assertions.PositiveT[SignedNumber](t, e, forwardArgs(msg, args)...)
 }
 
+// ProtoEqualf is the same as [ProtoEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func ProtoEqualf(t T, expected any, actual any, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqual(t, expected, actual, forwardArgs(msg, args)...)
+}
+
+// ProtoEqualIgnoringUnknownf is the same as [ProtoEqualIgnoringUnknown], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func ProtoEqualIgnoringUnknownf(t T, expected any, actual any, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqualIgnoringUnknown(t, expected, actual, forwardArgs(msg, args)...)
+}
+
 // Regexpf is the same as [Regexp], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1175,6 +1387,16 @@ func SeqContainsTf[E comparable](t T, iter iter.Seq[E], element E, msg string, a
 	return assertions.SeqContainsT[E](t, iter, element, forwardArgs(msg, args)...)
 }
 
+// SeqLenTf is the same as [SeqLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func SeqLenTf[E any](t T, iter iter.Seq[E], length int, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.SeqLenT[E](t, iter, length, forwardArgs(msg, args)...)
+}
+
 // SeqNotContainsTf is the same as [SeqNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1205,6 +1427,16 @@ func SliceEqualTf[E comparable](t T, listA []E, listB []E, msg string, args ...a
 	return assertions.SliceEqualT[E](t, listA, listB, forwardArgs(msg, args)...)
 }
 
+// SliceLenTf is the same as [SliceLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func SliceLenTf[Slice ~[]E, E any](t T, s Slice, length int, msg string, args ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	return assertions.SliceLenT[Slice, E](t, s, length, forwardArgs(msg, args)...)
+}
+
 // SliceNotContainsTf is the same as [SliceNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -6,10 +6,13 @@
 package assert
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"slices"
@@ -71,6 +74,33 @@ func TestBlockedTf(t *testing.T) {
 	})
 }
 
+func TestCompletesWithinf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := CompletesWithinf(mock, time.Second, func() {}, "test message")
+		if !result {
+			t.Error("CompletesWithinf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := CompletesWithinf(mock, 10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) }, "test message")
+		if result {
+			t.Error("CompletesWithinf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("CompletesWithinf should mark test as failed")
+		}
+	})
+}
+
 func TestConditionf(t *testing.T) {
 	t.Parallel()
 
@@ -152,6 +182,33 @@ func TestContainsf(t *testing.T) {
 	})
 }
 
+func TestDirEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := DirEqualf(mock, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		if !result {
+			t.Error("DirEqualf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := DirEqualf(mock, testDataPath(), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		if result {
+			t.Error("DirEqualf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("DirEqualf should mark test as failed")
+		}
+	})
+}
+
 func TestDirExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -368,6 +425,33 @@ func TestEqualExportedValuesf(t *testing.T) {
 	})
 }
 
+func TestEqualIgnoringFieldsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EqualIgnoringFieldsf(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}, "test message")
+		if !result {
+			t.Error("EqualIgnoringFieldsf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EqualIgnoringFieldsf(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}, "test message")
+		if result {
+			t.Error("EqualIgnoringFieldsf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("EqualIgnoringFieldsf should mark test as failed")
+		}
+	})
+}
+
 func TestEqualTf(t *testing.T) {
 	t.Parallel()
 
@@ -476,6 +560,33 @@ func TestErrorAsf(t *testing.T) {
 	})
 }
 
+func TestErrorAsTypef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		_, result := ErrorAsTypef[*dummyError](mock, fmt.Errorf("wrap: %w", &dummyError{}), "test message")
+		if !result {
+			t.Error("ErrorAsTypef should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		_, result := ErrorAsTypef[*dummyError](mock, ErrTest, "test message")
+		if result {
+			t.Error("ErrorAsTypef should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("ErrorAsTypef should mark test as failed")
+		}
+	})
+}
+
 func TestErrorContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -597,6 +708,33 @@ func TestEventuallyWithf(t *testing.T) {
 	})
 }
 
+func TestEventuallyWithContextf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EventuallyWithContextf(mock, context.Background(), func() bool { return true }, 20*time.Millisecond, "test message")
+		if !result {
+			t.Error("EventuallyWithContextf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := EventuallyWithContextf(mock, func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond, "test message")
+		if result {
+			t.Error("EventuallyWithContextf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("EventuallyWithContextf should mark test as failed")
+		}
+	})
+}
+
 func TestExactlyf(t *testing.T) {
 	t.Parallel()
 
@@ -624,6 +762,33 @@ func TestExactlyf(t *testing.T) {
 	})
 }
 
+func TestFSEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FSEqualf(mock, os.DirFS(testDataPath()), os.DirFS(testDataPath()), "test message")
+		if !result {
+			t.Error("FSEqualf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FSEqualf(mock, os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")), "test message")
+		if result {
+			t.Error("FSEqualf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FSEqualf should mark test as failed")
+		}
+	})
+}
+
 func TestFailf(t *testing.T) {
 	t.Parallel()
 
@@ -739,6 +904,60 @@ func TestFileEmptyf(t *testing.T) {
 	})
 }
 
+func TestFileEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualf(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		if !result {
+			t.Error("FileEqualf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualf(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"), "test message")
+		if result {
+			t.Error("FileEqualf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FileEqualf should mark test as failed")
+		}
+	})
+}
+
+func TestFileEqualBytesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualBytesf(mock, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		if !result {
+			t.Error("FileEqualBytesf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := FileEqualBytesf(mock, []byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		if result {
+			t.Error("FileEqualBytesf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("FileEqualBytesf should mark test as failed")
+		}
+	})
+}
+
 func TestFileExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -928,6 +1147,33 @@ func TestGreaterTf(t *testing.T) {
 	})
 }
 
+func TestGroupf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Groupf(mock, func(c *CollectT) { True(c, true); Equal(c, 1, 1) }, "test message")
+		if !result {
+			t.Error("Groupf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Groupf(mock, func(c *CollectT) { True(c, false); Equal(c, 1, 2) }, "test message")
+		if result {
+			t.Error("Groupf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Groupf should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPBodyContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -955,6 +1201,33 @@ func TestHTTPBodyContainsf(t *testing.T) {
 	})
 }
 
+func TestHTTPBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPBodyJSONEqf(mock, httpJSON, "GET", "/", nil, `{"hello": "world"}`, "test message")
+		if !result {
+			t.Error("HTTPBodyJSONEqf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPBodyJSONEqf(mock, httpJSON, "GET", "/", nil, `{"hello": "bob"}`, "test message")
+		if result {
+			t.Error("HTTPBodyJSONEqf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPBodyJSONEqf should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPBodyNotContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -1009,6 +1282,33 @@ func TestHTTPErrorf(t *testing.T) {
 	})
 }
 
+func TestHTTPHeaderf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPHeaderf(mock, httpJSON, "GET", "/", nil, "Content-Type", "application/json", "test message")
+		if !result {
+			t.Error("HTTPHeaderf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPHeaderf(mock, httpJSON, "GET", "/", nil, "Content-Type", "text/plain", "test message")
+		if result {
+			t.Error("HTTPHeaderf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPHeaderf should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPRedirectf(t *testing.T) {
 	t.Parallel()
 
@@ -1036,6 +1336,60 @@ func TestHTTPRedirectf(t *testing.T) {
 	})
 }
 
+func TestHTTPRoundTripBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripBodyJSONEqf(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`, "test message")
+		if !result {
+			t.Error("HTTPRoundTripBodyJSONEqf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripBodyJSONEqf(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`, "test message")
+		if result {
+			t.Error("HTTPRoundTripBodyJSONEqf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPRoundTripBodyJSONEqf should mark test as failed")
+		}
+	})
+}
+
+func TestHTTPRoundTripStatusCodef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripStatusCodef(mock, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		if !result {
+			t.Error("HTTPRoundTripStatusCodef should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := HTTPRoundTripStatusCodef(mock, httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		if result {
+			t.Error("HTTPRoundTripStatusCodef should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("HTTPRoundTripStatusCodef should mark test as failed")
+		}
+	})
+}
+
 func TestHTTPStatusCodef(t *testing.T) {
 	t.Parallel()
 
@@ -1792,6 +2146,60 @@ func TestJSONMarshalAsTf(t *testing.T) {
 	})
 }
 
+func TestJSONMatchesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesf(mock, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`, "test message")
+		if !result {
+			t.Error("JSONMatchesf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesf(mock, `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`, "test message")
+		if result {
+			t.Error("JSONMatchesf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("JSONMatchesf should mark test as failed")
+		}
+	})
+}
+
+func TestJSONMatchesTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesTf(mock, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`), "test message")
+		if !result {
+			t.Error("JSONMatchesTf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := JSONMatchesTf(mock, `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`), "test message")
+		if result {
+			t.Error("JSONMatchesTf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("JSONMatchesTf should mark test as failed")
+		}
+	})
+}
+
 func TestJSONUnmarshalAsTf(t *testing.T) {
 	t.Parallel()
 
@@ -2035,6 +2443,33 @@ func TestMapEqualTf(t *testing.T) {
 	})
 }
 
+func TestMapLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := MapLenTf(mock, map[string]string{"A": "B"}, 1, "test message")
+		if !result {
+			t.Error("MapLenTf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := MapLenTf(mock, map[string]string{"A": "B"}, 2, "test message")
+		if result {
+			t.Error("MapLenTf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("MapLenTf should mark test as failed")
+		}
+	})
+}
+
 func TestMapNotContainsTf(t *testing.T) {
 	t.Parallel()
 
@@ -2089,6 +2524,27 @@ func TestMapNotEqualTf(t *testing.T) {
 	})
 }
 
+func TestMaxAllocsPerRunf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockT)
+		result := MaxAllocsPerRunf(mock, 0, func() {}, "test message")
+		if !result {
+			t.Error("MaxAllocsPerRunf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockT)
+		result := MaxAllocsPerRunf(mock, 0, func() { _ = make([]byte, 1<<20) }, "test message")
+		if result {
+			t.Error("MaxAllocsPerRunf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("MaxAllocsPerRunf should mark test as failed")
+		}
+	})
+}
+
 func TestNegativef(t *testing.T) {
 	t.Parallel()
 
@@ -2873,6 +3329,33 @@ func TestPanicsf(t *testing.T) {
 	})
 }
 
+func TestPanicsMatchingf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsMatchingf(mock, func(v any) bool { return v == "panicking" }, func() { panic("panicking") }, "test message")
+		if !result {
+			t.Error("PanicsMatchingf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsMatchingf(mock, func(v any) bool { return v == "panicking" }, func() { panic("other") }, "test message")
+		if result {
+			t.Error("PanicsMatchingf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("PanicsMatchingf should mark test as failed")
+		}
+	})
+}
+
 func TestPanicsWithErrorf(t *testing.T) {
 	t.Parallel()
 
@@ -2900,6 +3383,33 @@ func TestPanicsWithErrorf(t *testing.T) {
 	})
 }
 
+func TestPanicsWithErrorIsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsWithErrorIsf(mock, ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }, "test message")
+		if !result {
+			t.Error("PanicsWithErrorIsf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := PanicsWithErrorIsf(mock, ErrTest, func() {}, "test message")
+		if result {
+			t.Error("PanicsWithErrorIsf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("PanicsWithErrorIsf should mark test as failed")
+		}
+	})
+}
+
 func TestPanicsWithValuef(t *testing.T) {
 	t.Parallel()
 
@@ -2981,6 +3491,44 @@ func TestPositiveTf(t *testing.T) {
 	})
 }
 
+func TestProtoEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Panics(t, func() {
+			ProtoEqualf(mock, "a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("ProtoEqualf should return true on panic")
+		}
+		if mock.failed {
+			t.Error("ProtoEqualf should panic as expected")
+		}
+	})
+}
+
+func TestProtoEqualIgnoringUnknownf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := Panics(t, func() {
+			ProtoEqualIgnoringUnknownf(mock, "a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("ProtoEqualIgnoringUnknownf should return true on panic")
+		}
+		if mock.failed {
+			t.Error("ProtoEqualIgnoringUnknownf should panic as expected")
+		}
+	})
+}
+
 func TestRegexpf(t *testing.T) {
 	t.Parallel()
 
@@ -3116,6 +3664,33 @@ func TestSeqContainsTf(t *testing.T) {
 	})
 }
 
+func TestSeqLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SeqLenTf(mock, slices.Values([]string{"A", "B"}), 2, "test message")
+		if !result {
+			t.Error("SeqLenTf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SeqLenTf(mock, slices.Values([]string{"A", "B"}), 1, "test message")
+		if result {
+			t.Error("SeqLenTf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("SeqLenTf should mark test as failed")
+		}
+	})
+}
+
 func TestSeqNotContainsTf(t *testing.T) {
 	t.Parallel()
 
@@ -3197,6 +3772,33 @@ func TestSliceEqualTf(t *testing.T) {
 	})
 }
 
+func TestSliceLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SliceLenTf(mock, []string{"A", "B"}, 2, "test message")
+		if !result {
+			t.Error("SliceLenTf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		result := SliceLenTf(mock, []string{"A", "B"}, 1, "test message")
+		if result {
+			t.Error("SliceLenTf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("SliceLenTf should mark test as failed")
+		}
+	})
+}
+
 func TestSliceNotContainsTf(t *testing.T) {
 	t.Parallel()
 
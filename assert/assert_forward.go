@@ -6,6 +6,7 @@
 package assert
 
 import (
+	"io/fs"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -24,7 +25,13 @@ type Assertions struct {
 }
 
 // New makes a new [Assertions] object for the specified [T] (e.g. [testing.T]).
-func New(t T) *Assertions {
+//
+// Options, e.g. [WithComparers], configure the assertions run with t.
+func New(t T, opts ...Option) *Assertions {
+	for _, apply := range opts {
+		apply(t)
+	}
+
 	return &Assertions{
 		T: t,
 	}
@@ -50,6 +57,26 @@ func (a *Assertions) Blockedf(ch any, msg string, args ...any) bool {
 	return assertions.Blocked(a.T, ch, forwardArgs(msg, args)...)
 }
 
+// CompletesWithin is the same as [CompletesWithin], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) CompletesWithin(d time.Duration, fn func(), msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.CompletesWithin(a.T, d, fn, msgAndArgs...)
+}
+
+// CompletesWithinf is the same as [Assertions.CompletesWithin], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) CompletesWithinf(d time.Duration, fn func(), msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.CompletesWithin(a.T, d, fn, forwardArgs(msg, args)...)
+}
+
 // Condition is the same as [Condition], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -90,6 +117,26 @@ func (a *Assertions) Containsf(s any, contains any, msg string, args ...any) boo
 	return assertions.Contains(a.T, s, contains, forwardArgs(msg, args)...)
 }
 
+// DirEqual is the same as [DirEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) DirEqual(expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.DirEqual(a.T, expected, actual, msgAndArgs...)
+}
+
+// DirEqualf is the same as [Assertions.DirEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) DirEqualf(expected string, actual string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.DirEqual(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
 // DirExists is the same as [DirExists], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -230,6 +277,26 @@ func (a *Assertions) EqualExportedValuesf(expected any, actual any, msg string,
 	return assertions.EqualExportedValues(a.T, expected, actual, forwardArgs(msg, args)...)
 }
 
+// EqualIgnoringFields is the same as [EqualIgnoringFields], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) EqualIgnoringFields(expected any, actual any, fields []string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.EqualIgnoringFields(a.T, expected, actual, fields, msgAndArgs...)
+}
+
+// EqualIgnoringFieldsf is the same as [Assertions.EqualIgnoringFields], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) EqualIgnoringFieldsf(expected any, actual any, fields []string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.EqualIgnoringFields(a.T, expected, actual, fields, forwardArgs(msg, args)...)
+}
+
 // EqualValues is the same as [EqualValues], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -350,6 +417,26 @@ func (a *Assertions) Exactlyf(expected any, actual any, msg string, args ...any)
 	return assertions.Exactly(a.T, expected, actual, forwardArgs(msg, args)...)
 }
 
+// FSEqual is the same as [FSEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FSEqual(expected fs.FS, actual fs.FS, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FSEqual(a.T, expected, actual, msgAndArgs...)
+}
+
+// FSEqualf is the same as [Assertions.FSEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FSEqualf(expected fs.FS, actual fs.FS, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FSEqual(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
 // Fail is the same as [Fail], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -430,6 +517,46 @@ func (a *Assertions) FileEmptyf(path string, msg string, args ...any) bool {
 	return assertions.FileEmpty(a.T, path, forwardArgs(msg, args)...)
 }
 
+// FileEqual is the same as [FileEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FileEqual(expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqual(a.T, expected, actual, msgAndArgs...)
+}
+
+// FileEqualf is the same as [Assertions.FileEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FileEqualf(expected string, actual string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqual(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
+// FileEqualBytes is the same as [FileEqualBytes], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FileEqualBytes(expected []byte, actual string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqualBytes(a.T, expected, actual, msgAndArgs...)
+}
+
+// FileEqualBytesf is the same as [Assertions.FileEqualBytes], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) FileEqualBytesf(expected []byte, actual string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.FileEqualBytes(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
 // FileExists is the same as [FileExists], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -530,6 +657,26 @@ func (a *Assertions) GreaterOrEqualf(e1 any, e2 any, msg string, args ...any) bo
 	return assertions.GreaterOrEqual(a.T, e1, e2, forwardArgs(msg, args)...)
 }
 
+// Group is the same as [Group], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) Group(block func(*CollectT), msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.Group(a.T, block, msgAndArgs...)
+}
+
+// Groupf is the same as [Assertions.Group], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) Groupf(block func(*CollectT), msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.Group(a.T, block, forwardArgs(msg, args)...)
+}
+
 // HTTPBodyContains is the same as [HTTPBodyContains], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -550,6 +697,26 @@ func (a *Assertions) HTTPBodyContainsf(handler http.HandlerFunc, method string,
 	return assertions.HTTPBodyContains(a.T, handler, method, url, values, str, forwardArgs(msg, args)...)
 }
 
+// HTTPBodyJSONEq is the same as [HTTPBodyJSONEq], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPBodyJSONEq(handler http.HandlerFunc, method string, url string, values url.Values, expected string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPBodyJSONEq(a.T, handler, method, url, values, expected, msgAndArgs...)
+}
+
+// HTTPBodyJSONEqf is the same as [Assertions.HTTPBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPBodyJSONEqf(handler http.HandlerFunc, method string, url string, values url.Values, expected string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPBodyJSONEq(a.T, handler, method, url, values, expected, forwardArgs(msg, args)...)
+}
+
 // HTTPBodyNotContains is the same as [HTTPBodyNotContains], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -590,6 +757,26 @@ func (a *Assertions) HTTPErrorf(handler http.HandlerFunc, method string, url str
 	return assertions.HTTPError(a.T, handler, method, url, values, forwardArgs(msg, args)...)
 }
 
+// HTTPHeader is the same as [HTTPHeader], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPHeader(handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPHeader(a.T, handler, method, url, values, header, expected, msgAndArgs...)
+}
+
+// HTTPHeaderf is the same as [Assertions.HTTPHeader], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPHeaderf(handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPHeader(a.T, handler, method, url, values, header, expected, forwardArgs(msg, args)...)
+}
+
 // HTTPRedirect is the same as [HTTPRedirect], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -610,6 +797,46 @@ func (a *Assertions) HTTPRedirectf(handler http.HandlerFunc, method string, url
 	return assertions.HTTPRedirect(a.T, handler, method, url, values, forwardArgs(msg, args)...)
 }
 
+// HTTPRoundTripBodyJSONEq is the same as [HTTPRoundTripBodyJSONEq], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPRoundTripBodyJSONEq(rt http.RoundTripper, req *http.Request, expected string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripBodyJSONEq(a.T, rt, req, expected, msgAndArgs...)
+}
+
+// HTTPRoundTripBodyJSONEqf is the same as [Assertions.HTTPRoundTripBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPRoundTripBodyJSONEqf(rt http.RoundTripper, req *http.Request, expected string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripBodyJSONEq(a.T, rt, req, expected, forwardArgs(msg, args)...)
+}
+
+// HTTPRoundTripStatusCode is the same as [HTTPRoundTripStatusCode], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPRoundTripStatusCode(rt http.RoundTripper, req *http.Request, statuscode int, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripStatusCode(a.T, rt, req, statuscode, msgAndArgs...)
+}
+
+// HTTPRoundTripStatusCodef is the same as [Assertions.HTTPRoundTripStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) HTTPRoundTripStatusCodef(rt http.RoundTripper, req *http.Request, statuscode int, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.HTTPRoundTripStatusCode(a.T, rt, req, statuscode, forwardArgs(msg, args)...)
+}
+
 // HTTPStatusCode is the same as [HTTPStatusCode], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -950,6 +1177,26 @@ func (a *Assertions) JSONEqBytesf(expected []byte, actual []byte, msg string, ar
 	return assertions.JSONEqBytes(a.T, expected, actual, forwardArgs(msg, args)...)
 }
 
+// JSONMatches is the same as [JSONMatches], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) JSONMatches(expected string, actual string, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatches(a.T, expected, actual, msgAndArgs...)
+}
+
+// JSONMatchesf is the same as [Assertions.JSONMatches], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) JSONMatchesf(expected string, actual string, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.JSONMatches(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
 // Kind is the same as [Kind], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1030,6 +1277,26 @@ func (a *Assertions) LessOrEqualf(e1 any, e2 any, msg string, args ...any) bool
 	return assertions.LessOrEqual(a.T, e1, e2, forwardArgs(msg, args)...)
 }
 
+// MaxAllocsPerRun is the same as [MaxAllocsPerRun], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) MaxAllocsPerRun(maxAllocs int, fn func(), msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.MaxAllocsPerRun(a.T, maxAllocs, fn, msgAndArgs...)
+}
+
+// MaxAllocsPerRunf is the same as [Assertions.MaxAllocsPerRun], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) MaxAllocsPerRunf(maxAllocs int, fn func(), msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.MaxAllocsPerRun(a.T, maxAllocs, fn, forwardArgs(msg, args)...)
+}
+
 // Negative is the same as [Negative], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1470,6 +1737,26 @@ func (a *Assertions) Panicsf(f func(), msg string, args ...any) bool {
 	return assertions.Panics(a.T, f, forwardArgs(msg, args)...)
 }
 
+// PanicsMatching is the same as [PanicsMatching], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) PanicsMatching(matcher func(any) bool, f func(), msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsMatching(a.T, matcher, f, msgAndArgs...)
+}
+
+// PanicsMatchingf is the same as [Assertions.PanicsMatching], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) PanicsMatchingf(matcher func(any) bool, f func(), msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsMatching(a.T, matcher, f, forwardArgs(msg, args)...)
+}
+
 // PanicsWithError is the same as [PanicsWithError], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1490,6 +1777,26 @@ func (a *Assertions) PanicsWithErrorf(errString string, f func(), msg string, ar
 	return assertions.PanicsWithError(a.T, errString, f, forwardArgs(msg, args)...)
 }
 
+// PanicsWithErrorIs is the same as [PanicsWithErrorIs], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) PanicsWithErrorIs(target error, f func(), msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsWithErrorIs(a.T, target, f, msgAndArgs...)
+}
+
+// PanicsWithErrorIsf is the same as [Assertions.PanicsWithErrorIs], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) PanicsWithErrorIsf(target error, f func(), msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.PanicsWithErrorIs(a.T, target, f, forwardArgs(msg, args)...)
+}
+
 // PanicsWithValue is the same as [PanicsWithValue], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
@@ -1530,6 +1837,46 @@ func (a *Assertions) Positivef(e any, msg string, args ...any) bool {
 	return assertions.Positive(a.T, e, forwardArgs(msg, args)...)
 }
 
+// ProtoEqual is the same as [ProtoEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) ProtoEqual(expected any, actual any, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqual(a.T, expected, actual, msgAndArgs...)
+}
+
+// ProtoEqualf is the same as [Assertions.ProtoEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) ProtoEqualf(expected any, actual any, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqual(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
+// ProtoEqualIgnoringUnknown is the same as [ProtoEqualIgnoringUnknown], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) ProtoEqualIgnoringUnknown(expected any, actual any, msgAndArgs ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqualIgnoringUnknown(a.T, expected, actual, msgAndArgs...)
+}
+
+// ProtoEqualIgnoringUnknownf is the same as [Assertions.ProtoEqualIgnoringUnknown], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and continues execution.
+func (a *Assertions) ProtoEqualIgnoringUnknownf(expected any, actual any, msg string, args ...any) bool {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	return assertions.ProtoEqualIgnoringUnknown(a.T, expected, actual, forwardArgs(msg, args)...)
+}
+
 // Regexp is the same as [Regexp], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and continues execution.
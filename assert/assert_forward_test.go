@@ -6,10 +6,13 @@
 package assert
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -45,6 +48,35 @@ func TestAssertionsBlocked(t *testing.T) {
 	})
 }
 
+func TestAssertionsCompletesWithin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.CompletesWithin(time.Second, func() {})
+		if !result {
+			t.Error("Assertions.CompletesWithin should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.CompletesWithin(10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })
+		if result {
+			t.Error("Assertions.CompletesWithin should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.CompletesWithin should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsCondition(t *testing.T) {
 	t.Parallel()
 
@@ -103,6 +135,35 @@ func TestAssertionsContains(t *testing.T) {
 	})
 }
 
+func TestAssertionsDirEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.DirEqual(filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+		if !result {
+			t.Error("Assertions.DirEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.DirEqual(testDataPath(), filepath.Join(testDataPath(), "existing_dir"))
+		if result {
+			t.Error("Assertions.DirEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.DirEqual should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsDirExists(t *testing.T) {
 	t.Parallel()
 
@@ -306,6 +367,35 @@ func TestAssertionsEqualExportedValues(t *testing.T) {
 	})
 }
 
+func TestAssertionsEqualIgnoringFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.EqualIgnoringFields(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+		if !result {
+			t.Error("Assertions.EqualIgnoringFields should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.EqualIgnoringFields(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"})
+		if result {
+			t.Error("Assertions.EqualIgnoringFields should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.EqualIgnoringFields should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsEqualValues(t *testing.T) {
 	t.Parallel()
 
@@ -480,6 +570,35 @@ func TestAssertionsExactly(t *testing.T) {
 	})
 }
 
+func TestAssertionsFSEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FSEqual(os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+		if !result {
+			t.Error("Assertions.FSEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FSEqual(os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")))
+		if result {
+			t.Error("Assertions.FSEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.FSEqual should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsFail(t *testing.T) {
 	t.Parallel()
 
@@ -574,6 +693,64 @@ func TestAssertionsFileEmpty(t *testing.T) {
 	})
 }
 
+func TestAssertionsFileEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FileEqual(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+		if !result {
+			t.Error("Assertions.FileEqual should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FileEqual(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"))
+		if result {
+			t.Error("Assertions.FileEqual should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.FileEqual should mark test as failed")
+		}
+	})
+}
+
+func TestAssertionsFileEqualBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FileEqualBytes([]byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		if !result {
+			t.Error("Assertions.FileEqualBytes should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FileEqualBytes([]byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		if result {
+			t.Error("Assertions.FileEqualBytes should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.FileEqualBytes should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsFileExists(t *testing.T) {
 	t.Parallel()
 
@@ -719,6 +896,35 @@ func TestAssertionsGreaterOrEqual(t *testing.T) {
 	})
 }
 
+func TestAssertionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Group(func(c *CollectT) { True(c, true); Equal(c, 1, 1) })
+		if !result {
+			t.Error("Assertions.Group should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Group(func(c *CollectT) { True(c, false); Equal(c, 1, 2) })
+		if result {
+			t.Error("Assertions.Group should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.Group should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyContains(t *testing.T) {
 	t.Parallel()
 
@@ -748,6 +954,35 @@ func TestAssertionsHTTPBodyContains(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPBodyJSONEq(httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+		if !result {
+			t.Error("Assertions.HTTPBodyJSONEq should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPBodyJSONEq(httpJSON, "GET", "/", nil, `{"hello": "bob"}`)
+		if result {
+			t.Error("Assertions.HTTPBodyJSONEq should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPBodyJSONEq should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyNotContains(t *testing.T) {
 	t.Parallel()
 
@@ -806,6 +1041,35 @@ func TestAssertionsHTTPError(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPHeader(httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+		if !result {
+			t.Error("Assertions.HTTPHeader should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPHeader(httpJSON, "GET", "/", nil, "Content-Type", "text/plain")
+		if result {
+			t.Error("Assertions.HTTPHeader should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPHeader should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPRedirect(t *testing.T) {
 	t.Parallel()
 
@@ -835,6 +1099,64 @@ func TestAssertionsHTTPRedirect(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPRoundTripBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripBodyJSONEq(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+		if !result {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEq should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripBodyJSONEq(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`)
+		if result {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEq should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEq should mark test as failed")
+		}
+	})
+}
+
+func TestAssertionsHTTPRoundTripStatusCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripStatusCode(httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		if !result {
+			t.Error("Assertions.HTTPRoundTripStatusCode should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripStatusCode(httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		if result {
+			t.Error("Assertions.HTTPRoundTripStatusCode should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripStatusCode should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPStatusCode(t *testing.T) {
 	t.Parallel()
 
@@ -1291,15 +1613,44 @@ func TestAssertionsJSONEq(t *testing.T) {
 		a := New(mock)
 		result := a.JSONEq(`{"hello": "world", "foo": "bar"}`, `[{"foo": "bar"}, {"hello": "world"}]`)
 		if result {
-			t.Error("Assertions.JSONEq should return false on failure")
+			t.Error("Assertions.JSONEq should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.JSONEq should mark test as failed")
+		}
+	})
+}
+
+func TestAssertionsJSONEqBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.JSONEqBytes([]byte(`{"hello": "world", "foo": "bar"}`), []byte(`{"foo": "bar", "hello": "world"}`))
+		if !result {
+			t.Error("Assertions.JSONEqBytes should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.JSONEqBytes([]byte(`{"hello": "world", "foo": "bar"}`), []byte(`[{"foo": "bar"}, {"hello": "world"}]`))
+		if result {
+			t.Error("Assertions.JSONEqBytes should return false on failure")
 		}
 		if !mock.failed {
-			t.Error("Assertions.JSONEq should mark test as failed")
+			t.Error("Assertions.JSONEqBytes should mark test as failed")
 		}
 	})
 }
 
-func TestAssertionsJSONEqBytes(t *testing.T) {
+func TestAssertionsJSONMatches(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -1307,9 +1658,9 @@ func TestAssertionsJSONEqBytes(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.JSONEqBytes([]byte(`{"hello": "world", "foo": "bar"}`), []byte(`{"foo": "bar", "hello": "world"}`))
+		result := a.JSONMatches(`{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
 		if !result {
-			t.Error("Assertions.JSONEqBytes should return true on success")
+			t.Error("Assertions.JSONMatches should return true on success")
 		}
 	})
 
@@ -1318,12 +1669,12 @@ func TestAssertionsJSONEqBytes(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.JSONEqBytes([]byte(`{"hello": "world", "foo": "bar"}`), []byte(`[{"foo": "bar"}, {"hello": "world"}]`))
+		result := a.JSONMatches(`{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`)
 		if result {
-			t.Error("Assertions.JSONEqBytes should return false on failure")
+			t.Error("Assertions.JSONMatches should return false on failure")
 		}
 		if !mock.failed {
-			t.Error("Assertions.JSONEqBytes should mark test as failed")
+			t.Error("Assertions.JSONMatches should mark test as failed")
 		}
 	})
 }
@@ -1444,6 +1795,29 @@ func TestAssertionsLessOrEqual(t *testing.T) {
 	})
 }
 
+func TestAssertionsMaxAllocsPerRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockT)
+		a := New(mock)
+		result := a.MaxAllocsPerRun(0, func() {})
+		if !result {
+			t.Error("Assertions.MaxAllocsPerRun should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockT)
+		a := New(mock)
+		result := a.MaxAllocsPerRun(0, func() { _ = make([]byte, 1<<20) })
+		if result {
+			t.Error("Assertions.MaxAllocsPerRun should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.MaxAllocsPerRun should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsNegative(t *testing.T) {
 	t.Parallel()
 
@@ -2054,6 +2428,35 @@ func TestAssertionsPanics(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsMatching(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsMatching(func(v any) bool { return v == "panicking" }, func() { panic("panicking") })
+		if !result {
+			t.Error("Assertions.PanicsMatching should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsMatching(func(v any) bool { return v == "panicking" }, func() { panic("other") })
+		if result {
+			t.Error("Assertions.PanicsMatching should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.PanicsMatching should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithError(t *testing.T) {
 	t.Parallel()
 
@@ -2083,6 +2486,35 @@ func TestAssertionsPanicsWithError(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsWithErrorIs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsWithErrorIs(ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) })
+		if !result {
+			t.Error("Assertions.PanicsWithErrorIs should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsWithErrorIs(ErrTest, func() {})
+		if result {
+			t.Error("Assertions.PanicsWithErrorIs should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.PanicsWithErrorIs should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithValue(t *testing.T) {
 	t.Parallel()
 
@@ -2141,6 +2573,46 @@ func TestAssertionsPositive(t *testing.T) {
 	})
 }
 
+func TestAssertionsProtoEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Panics(func() {
+			a.ProtoEqual("a", "a")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("Assertions.ProtoEqual should return true on panic")
+		}
+		if mock.failed {
+			t.Error("Assertions.ProtoEqual should panic as expected")
+		}
+	})
+}
+
+func TestAssertionsProtoEqualIgnoringUnknown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Panics(func() {
+			a.ProtoEqualIgnoringUnknown("a", "a")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("Assertions.ProtoEqualIgnoringUnknown should return true on panic")
+		}
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualIgnoringUnknown should panic as expected")
+		}
+	})
+}
+
 func TestAssertionsRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -2413,6 +2885,35 @@ func TestAssertionsBlockedf(t *testing.T) {
 	})
 }
 
+func TestAssertionsCompletesWithinf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.CompletesWithinf(time.Second, func() {}, "test message")
+		if !result {
+			t.Error("Assertions.CompletesWithinf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.CompletesWithinf(10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) }, "test message")
+		if result {
+			t.Error("Assertions.CompletesWithinf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.CompletesWithinf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsConditionf(t *testing.T) {
 	t.Parallel()
 
@@ -2471,6 +2972,35 @@ func TestAssertionsContainsf(t *testing.T) {
 	})
 }
 
+func TestAssertionsDirEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.DirEqualf(filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		if !result {
+			t.Error("Assertions.DirEqualf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.DirEqualf(testDataPath(), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		if result {
+			t.Error("Assertions.DirEqualf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.DirEqualf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsDirExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -2674,6 +3204,35 @@ func TestAssertionsEqualExportedValuesf(t *testing.T) {
 	})
 }
 
+func TestAssertionsEqualIgnoringFieldsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.EqualIgnoringFieldsf(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}, "test message")
+		if !result {
+			t.Error("Assertions.EqualIgnoringFieldsf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.EqualIgnoringFieldsf(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}, "test message")
+		if result {
+			t.Error("Assertions.EqualIgnoringFieldsf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.EqualIgnoringFieldsf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsEqualValuesf(t *testing.T) {
 	t.Parallel()
 
@@ -2848,6 +3407,35 @@ func TestAssertionsExactlyf(t *testing.T) {
 	})
 }
 
+func TestAssertionsFSEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FSEqualf(os.DirFS(testDataPath()), os.DirFS(testDataPath()), "test message")
+		if !result {
+			t.Error("Assertions.FSEqualf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FSEqualf(os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")), "test message")
+		if result {
+			t.Error("Assertions.FSEqualf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.FSEqualf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsFailf(t *testing.T) {
 	t.Parallel()
 
@@ -2864,27 +3452,85 @@ func TestAssertionsFailf(t *testing.T) {
 			t.Error("Assertions.Failf should mark test as failed")
 		}
 	})
-}
-
-func TestAssertionsFailNowf(t *testing.T) {
-	t.Parallel()
+}
+
+func TestAssertionsFailNowf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		result := a.FailNowf("failed", "test message")
+		if result {
+			t.Error("Assertions.FailNowf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.FailNowf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsFalsef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Falsef(1 == 0, "test message")
+		if !result {
+			t.Error("Assertions.Falsef should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Falsef(1 == 1, "test message")
+		if result {
+			t.Error("Assertions.Falsef should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.Falsef should mark test as failed")
+		}
+	})
+}
+
+func TestAssertionsFileEmptyf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.FileEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
+		if !result {
+			t.Error("Assertions.FileEmptyf should return true on success")
+		}
+	})
 
 	t.Run("failure", func(t *testing.T) {
 		t.Parallel()
 
-		mock := new(mockFailNowT)
+		mock := new(mockT)
 		a := New(mock)
-		result := a.FailNowf("failed", "test message")
+		result := a.FileEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
 		if result {
-			t.Error("Assertions.FailNowf should return false on failure")
+			t.Error("Assertions.FileEmptyf should return false on failure")
 		}
 		if !mock.failed {
-			t.Error("Assertions.FailNowf should call FailNow()")
+			t.Error("Assertions.FileEmptyf should mark test as failed")
 		}
 	})
 }
 
-func TestAssertionsFalsef(t *testing.T) {
+func TestAssertionsFileEqualf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2892,9 +3538,9 @@ func TestAssertionsFalsef(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.Falsef(1 == 0, "test message")
+		result := a.FileEqualf(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		if !result {
-			t.Error("Assertions.Falsef should return true on success")
+			t.Error("Assertions.FileEqualf should return true on success")
 		}
 	})
 
@@ -2903,17 +3549,17 @@ func TestAssertionsFalsef(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.Falsef(1 == 1, "test message")
+		result := a.FileEqualf(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"), "test message")
 		if result {
-			t.Error("Assertions.Falsef should return false on failure")
+			t.Error("Assertions.FileEqualf should return false on failure")
 		}
 		if !mock.failed {
-			t.Error("Assertions.Falsef should mark test as failed")
+			t.Error("Assertions.FileEqualf should mark test as failed")
 		}
 	})
 }
 
-func TestAssertionsFileEmptyf(t *testing.T) {
+func TestAssertionsFileEqualBytesf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2921,9 +3567,9 @@ func TestAssertionsFileEmptyf(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.FileEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
+		result := a.FileEqualBytesf([]byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		if !result {
-			t.Error("Assertions.FileEmptyf should return true on success")
+			t.Error("Assertions.FileEqualBytesf should return true on success")
 		}
 	})
 
@@ -2932,12 +3578,12 @@ func TestAssertionsFileEmptyf(t *testing.T) {
 
 		mock := new(mockT)
 		a := New(mock)
-		result := a.FileEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
+		result := a.FileEqualBytesf([]byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		if result {
-			t.Error("Assertions.FileEmptyf should return false on failure")
+			t.Error("Assertions.FileEqualBytesf should return false on failure")
 		}
 		if !mock.failed {
-			t.Error("Assertions.FileEmptyf should mark test as failed")
+			t.Error("Assertions.FileEqualBytesf should mark test as failed")
 		}
 	})
 }
@@ -3087,6 +3733,35 @@ func TestAssertionsGreaterOrEqualf(t *testing.T) {
 	})
 }
 
+func TestAssertionsGroupf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Groupf(func(c *CollectT) { True(c, true); Equal(c, 1, 1) }, "test message")
+		if !result {
+			t.Error("Assertions.Groupf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Groupf(func(c *CollectT) { True(c, false); Equal(c, 1, 2) }, "test message")
+		if result {
+			t.Error("Assertions.Groupf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.Groupf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -3116,6 +3791,35 @@ func TestAssertionsHTTPBodyContainsf(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPBodyJSONEqf(httpJSON, "GET", "/", nil, `{"hello": "world"}`, "test message")
+		if !result {
+			t.Error("Assertions.HTTPBodyJSONEqf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPBodyJSONEqf(httpJSON, "GET", "/", nil, `{"hello": "bob"}`, "test message")
+		if result {
+			t.Error("Assertions.HTTPBodyJSONEqf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPBodyJSONEqf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyNotContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -3174,6 +3878,35 @@ func TestAssertionsHTTPErrorf(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPHeaderf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPHeaderf(httpJSON, "GET", "/", nil, "Content-Type", "application/json", "test message")
+		if !result {
+			t.Error("Assertions.HTTPHeaderf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPHeaderf(httpJSON, "GET", "/", nil, "Content-Type", "text/plain", "test message")
+		if result {
+			t.Error("Assertions.HTTPHeaderf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPHeaderf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPRedirectf(t *testing.T) {
 	t.Parallel()
 
@@ -3203,6 +3936,64 @@ func TestAssertionsHTTPRedirectf(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPRoundTripBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripBodyJSONEqf(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`, "test message")
+		if !result {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEqf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripBodyJSONEqf(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`, "test message")
+		if result {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEqf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEqf should mark test as failed")
+		}
+	})
+}
+
+func TestAssertionsHTTPRoundTripStatusCodef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripStatusCodef(httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		if !result {
+			t.Error("Assertions.HTTPRoundTripStatusCodef should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.HTTPRoundTripStatusCodef(httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		if result {
+			t.Error("Assertions.HTTPRoundTripStatusCodef should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripStatusCodef should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsHTTPStatusCodef(t *testing.T) {
 	t.Parallel()
 
@@ -3696,6 +4487,35 @@ func TestAssertionsJSONEqBytesf(t *testing.T) {
 	})
 }
 
+func TestAssertionsJSONMatchesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.JSONMatchesf(`{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`, "test message")
+		if !result {
+			t.Error("Assertions.JSONMatchesf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.JSONMatchesf(`{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`, "test message")
+		if result {
+			t.Error("Assertions.JSONMatchesf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.JSONMatchesf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsKindf(t *testing.T) {
 	t.Parallel()
 
@@ -3812,6 +4632,29 @@ func TestAssertionsLessOrEqualf(t *testing.T) {
 	})
 }
 
+func TestAssertionsMaxAllocsPerRunf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockT)
+		a := New(mock)
+		result := a.MaxAllocsPerRunf(0, func() {}, "test message")
+		if !result {
+			t.Error("Assertions.MaxAllocsPerRunf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockT)
+		a := New(mock)
+		result := a.MaxAllocsPerRunf(0, func() { _ = make([]byte, 1<<20) }, "test message")
+		if result {
+			t.Error("Assertions.MaxAllocsPerRunf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.MaxAllocsPerRunf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsNegativef(t *testing.T) {
 	t.Parallel()
 
@@ -4422,6 +5265,35 @@ func TestAssertionsPanicsf(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsMatchingf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsMatchingf(func(v any) bool { return v == "panicking" }, func() { panic("panicking") }, "test message")
+		if !result {
+			t.Error("Assertions.PanicsMatchingf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsMatchingf(func(v any) bool { return v == "panicking" }, func() { panic("other") }, "test message")
+		if result {
+			t.Error("Assertions.PanicsMatchingf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.PanicsMatchingf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithErrorf(t *testing.T) {
 	t.Parallel()
 
@@ -4451,6 +5323,35 @@ func TestAssertionsPanicsWithErrorf(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsWithErrorIsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsWithErrorIsf(ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }, "test message")
+		if !result {
+			t.Error("Assertions.PanicsWithErrorIsf should return true on success")
+		}
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.PanicsWithErrorIsf(ErrTest, func() {}, "test message")
+		if result {
+			t.Error("Assertions.PanicsWithErrorIsf should return false on failure")
+		}
+		if !mock.failed {
+			t.Error("Assertions.PanicsWithErrorIsf should mark test as failed")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithValuef(t *testing.T) {
 	t.Parallel()
 
@@ -4509,6 +5410,46 @@ func TestAssertionsPositivef(t *testing.T) {
 	})
 }
 
+func TestAssertionsProtoEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Panics(func() {
+			a.ProtoEqualf("a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("Assertions.ProtoEqualf should return true on panic")
+		}
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualf should panic as expected")
+		}
+	})
+}
+
+func TestAssertionsProtoEqualIgnoringUnknownf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		a := New(mock)
+		result := a.Panics(func() {
+			a.ProtoEqualIgnoringUnknownf("a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if !result {
+			t.Error("Assertions.ProtoEqualIgnoringUnknownf should return true on panic")
+		}
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualIgnoringUnknownf should panic as expected")
+		}
+	})
+}
+
 func TestAssertionsRegexpf(t *testing.T) {
 	t.Parallel()
 
@@ -28,8 +28,8 @@ type (
 
 	// CollectT implements the [T] interface and collects all errors.
 	//
-	// [CollectT] is specifically intended to be used with [EventuallyWith] and
-	// should not be used outside of that context.
+	// [CollectT] is specifically intended to be used with [EventuallyWith] and [Group]
+	// and should not be used outside of these contexts.
 	CollectT = assertions.CollectT
 
 	// CollectibleConditioner is a function used in asynchronous condition assertions that use [CollectT].
@@ -40,6 +40,11 @@ type (
 	// call into fake-time polling. See [WithSynctest] for details.
 	CollectibleConditioner = assertions.CollectibleConditioner
 
+	// Comparer is a custom equality function for values of a given type.
+	//
+	// Comparers are built with [CompareWith] and configured for a test with [WithComparers].
+	Comparer = assertions.Comparer
+
 	// ComparisonAssertionFunc is a common function prototype when comparing two values.  Can be useful
 	// for table driven tests.
 	ComparisonAssertionFunc = assertions.ComparisonAssertionFunc
@@ -56,6 +61,21 @@ type (
 	// for table driven tests.
 	ErrorAssertionFunc = assertions.ErrorAssertionFunc
 
+	// Failure is a structured record of a failed assertion.
+	//
+	// Failures are passed to the [FailureReporter] hooks registered with [RegisterFailureReporter]
+	// or [WithFailureReporter], e.g. to produce JSON reports or annotations for a CI system.
+	//
+	// Failures collected by a [CollectT] are not reported: only the failure of the enclosing assertion
+	// (e.g. [EventuallyWith] or [Group]) is.
+	Failure = assertions.Failure
+
+	// FailureReporter is a hook called with every failed assertion.
+	//
+	// Reporters are called after the failure has been reported to [T]
+	// and must be safe for concurrent use by parallel tests.
+	FailureReporter = assertions.FailureReporter
+
 	// H is an interface for types that implement the Helper method.
 	// This allows marking functions as test helpers, e.g. [testing.T.Helper].
 	H = assertions.H
@@ -75,6 +95,17 @@ type (
 	// The [WithSynctest] wrapper opts a call into fake-time polling.
 	NeverConditioner = assertions.NeverConditioner
 
+	// Option configures the assertions run with a given [T].
+	//
+	// Options are passed to [New]. They may also be applied directly to a test, e.g. when using
+	// assertion functions rather than methods:
+	//
+	// 	assert.WithComparers(comparers...)(t)
+	//
+	// The configuration is removed when the test completes: options require a [T] supporting Cleanup,
+	// such as [testing.T]. Applying an option to another [T] reports an error.
+	Option = assertions.Option
+
 	// Ordered is a standard ordered type (i.e. types that support "<": [cmp.Ordered]) plus []byte and [time.Time].
 	//
 	// This is used by [GreaterT], [GreaterOrEqualT], [LessT], [LessOrEqualT], [IsIncreasingT], [IsDecreasingT].
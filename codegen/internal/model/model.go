@@ -200,6 +200,7 @@ type Function struct {
 	IsHelper      bool
 	IsDeprecated  bool
 	IsConstructor bool
+	IsSerial      bool // generated tests must not run in parallel
 	Tests         []Test
 	// extraneous information when scanning in collectDoc mode
 	Domain        string
@@ -286,14 +287,25 @@ func (f Function) HasSuccessTest() bool {
 	})
 }
 
+// ReturnsValue indicates that the assertion returns a value along with its boolean outcome,
+// e.g. ErrorAsType() returns (E, bool).
+//
+// Such assertions return only this value in the require variants.
+func (f Function) ReturnsValue() bool {
+	return len(f.Returns) > 1
+}
+
 // GenericSuffix provides a type parameter instantiation for methods which cannot infer
-// type parameters from their arguments. At this moment, only one such case exists: OfTypeT().
+// type parameters from their arguments. At this moment, such cases are: OfTypeT() and ErrorAsType().
 func (f Function) GenericSuffix() string {
-	if strings.HasSuffix(f.Name, "OfTypeT") {
+	switch {
+	case strings.HasSuffix(f.Name, "OfTypeT"):
 		return "[myType]"
+	case f.Name == "ErrorAsType":
+		return "[*dummyError]"
+	default:
+		return ""
 	}
-
-	return ""
 }
 
 // FailMsg returns an error message to report in tests.
@@ -425,6 +437,8 @@ func (t CommentTag) String() string {
 		return "comment-tag-note"
 	case CommentTagDomainDescription:
 		return "comment-tag-domain-description"
+	case CommentTagSerial:
+		return "comment-tag-serial"
 	default:
 		return "invalid-value"
 	}
@@ -438,6 +452,7 @@ const (
 	CommentTagNote
 	CommentTagDomainDescription
 	CommentTagOpposite
+	CommentTagSerial
 )
 
 type ExtraComment struct {
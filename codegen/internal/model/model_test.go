@@ -639,6 +639,31 @@ func TestGenericSuffix(t *testing.T) {
 	if got := fn.GenericSuffix(); got != myType {
 		t.Errorf("GenericSuffix() = %q, want %q", got, myType)
 	}
+
+	fn.Name = "ErrorAsType"
+	if got := fn.GenericSuffix(); got != "[*dummyError]" {
+		t.Errorf("GenericSuffix() = %q, want %q", got, "[*dummyError]")
+	}
+}
+
+func TestReturnsValue(t *testing.T) {
+	t.Parallel()
+
+	fn := Function{
+		Name:    "Equal",
+		Returns: Parameters{{GoType: "bool"}},
+	}
+	if fn.ReturnsValue() {
+		t.Error("ReturnsValue() should be false with a single bool return")
+	}
+
+	fn = Function{
+		Name:    "ErrorAsType",
+		Returns: Parameters{{GoType: "E"}, {GoType: "bool"}},
+	}
+	if !fn.ReturnsValue() {
+		t.Error("ReturnsValue() should be true with a value and a bool return")
+	}
 }
 
 func TestFailMsg(t *testing.T) {
@@ -5,6 +5,7 @@ package parser
 
 import (
 	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/go-openapi/testify/codegen/v2/internal/model"
@@ -19,6 +20,7 @@ import (
 //   - note: <value>             - multi-line note
 //   - mention: <value>          - single-line mention
 //   - opposite: <value>         - name of the logical opposite assertion (if any)
+//   - serial: <value>           - the reason why generated tests must not run in parallel
 //
 // Multi-line tags continue until the next tagged line or end of text.
 func ParseTaggedComments(text string) []model.ExtraComment {
@@ -28,6 +30,7 @@ func ParseTaggedComments(text string) []model.ExtraComment {
 		notePrefix       = "note"
 		mentionPrefix    = "mention"
 		oppositePrefix   = "opposite"
+		serialPrefix     = "serial"
 	)
 
 	inValue := false
@@ -36,6 +39,7 @@ func ParseTaggedComments(text string) []model.ExtraComment {
 	startValueNote := StartValueFunc(notePrefix)
 	startValueMention := StartValueFunc(mentionPrefix)
 	startValueOpposite := StartValueFunc(oppositePrefix)
+	startValueSerial := StartValueFunc(serialPrefix)
 
 	startTaggedValue := func(line string) (key string, val string, tag model.CommentTag, multiline bool, ok bool) {
 		val, ok = startValueDomain(line)
@@ -58,6 +62,10 @@ func ParseTaggedComments(text string) []model.ExtraComment {
 		if ok {
 			return "", val, model.CommentTagOpposite, false, true
 		}
+		val, ok = startValueSerial(line)
+		if ok {
+			return "", val, model.CommentTagSerial, false, true
+		}
 
 		return "", "", model.CommentTagNone, false, false
 	}
@@ -155,3 +163,10 @@ func DomainFromExtraComments(taggedComments []model.ExtraComment) string {
 
 	return ""
 }
+
+// IsSerialFromExtraComments tells if a "serial" tag is present in the tagged comments.
+func IsSerialFromExtraComments(taggedComments []model.ExtraComment) bool {
+	return slices.ContainsFunc(taggedComments, func(taggedComment model.ExtraComment) bool {
+		return taggedComment.Tag == model.CommentTagSerial
+	})
+}
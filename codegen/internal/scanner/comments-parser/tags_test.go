@@ -87,6 +87,21 @@ func TestDomainFromExtraComments(t *testing.T) {
 	}
 }
 
+func TestIsSerialFromExtraComments(t *testing.T) {
+	t.Parallel()
+
+	if IsSerialFromExtraComments([]model.ExtraComment{{Tag: model.CommentTagDomain, Key: "performance"}}) {
+		t.Error("expected IsSerialFromExtraComments() to be false without a serial tag")
+	}
+
+	if !IsSerialFromExtraComments([]model.ExtraComment{
+		{Tag: model.CommentTagDomain, Key: "performance"},
+		{Tag: model.CommentTagSerial, Text: "reason"},
+	}) {
+		t.Error("expected IsSerialFromExtraComments() to be true with a serial tag")
+	}
+}
+
 /* Test case iterators */
 
 type parseTaggedCommentsCase struct {
@@ -147,6 +162,13 @@ And this too.`,
 				{Tag: model.CommentTagMention, Key: "", Text: "Related to issue #123"},
 			},
 		},
+		{
+			name:  "serial tag",
+			input: `serial: testing.AllocsPerRun must not run in parallel tests`,
+			expected: []model.ExtraComment{
+				{Tag: model.CommentTagSerial, Key: "", Text: "testing.AllocsPerRun must not run in parallel tests"},
+			},
+		},
 		{
 			name: "mixed tags",
 			input: `domain: error
@@ -193,8 +193,11 @@ func (s *Scanner) addFunction(object *types.Func) {
 		function.Tests[i] = test
 	}
 
+	extraComments := s.commentExtractor.ExtractExtraComments(object)
+	function.IsSerial = parser.IsSerialFromExtraComments(extraComments)
+
 	if s.collectDoc {
-		function.ExtraComments = s.commentExtractor.ExtractExtraComments(object)
+		function.ExtraComments = extraComments
 		pos := s.fileSet.Position(object.Pos())
 		function.SourceLink = &pos
 		function.Domain = parser.DomainFromExtraComments(function.ExtraComments)
@@ -18,6 +18,8 @@
 // The [require] package provides the same assertions but with fatal checks that stop
 // test execution immediately on failure via [testing.T.FailNow].
 //
+// The [snapshot] package provides golden-file assertions.
+//
 // # Key Differences from stretchr/testify
 //
 // This fork prioritizes:
@@ -31,7 +33,7 @@
 //
 // YAML assertions require importing the enable package:
 //
-//	import _ "github.com/go-openapi/testify/v2/enable/yaml"
+//	import _ "github.com/go-openapi/testify/enable/yaml/v2"
 //
 // Without this import, [assert.YAMLEq] and [require.YAMLEq] will panic loudly with a helpful error message.
 //
@@ -46,6 +48,7 @@
 // [mockery]: https://github.com/vektra/mockery
 // [require]: https://pkg.go.dev/github.com/go-openapi/testify/v2/require
 // [require.YAMLEq]: https://pkg.go.dev/github.com/go-openapi/testify/v2/require#YAMLEq
+// [snapshot]: https://pkg.go.dev/github.com/go-openapi/testify/v2/snapshot
 // [spew]: https://github.com/go-openapi/testify/tree/master/internal/spew
 // [testifylint]: https://github.com/Antonboom/testifylint
 package testify
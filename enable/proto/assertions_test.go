@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package proto
+
+import (
+	"fmt"
+	"testing"
+
+	target "github.com/go-openapi/testify/v2/assert"
+
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func TestProtoEqual_EqualMessages(t *testing.T) {
+	t.Parallel()
+
+	mock := new(testing.T)
+	target.True(t, target.ProtoEqual(mock, wrapperspb.String("hello"), wrapperspb.String("hello")))
+}
+
+func TestProtoEqual_IgnoresInternalState(t *testing.T) {
+	t.Parallel()
+
+	expected := wrapperspb.String("hello")
+	actual := wrapperspb.String("hello")
+	_ = proto.Size(actual) // populates the internal size cache of actual
+
+	mock := new(testing.T)
+	target.True(t, target.ProtoEqual(mock, expected, actual))
+}
+
+func TestProtoEqual_NestedMessages(t *testing.T) {
+	t.Parallel()
+
+	expected, err := structpb.NewStruct(map[string]any{"name": "x", "tags": []any{"a", "b"}})
+	target.NoError(t, err)
+	actual, err := structpb.NewStruct(map[string]any{"tags": []any{"a", "b"}, "name": "x"})
+	target.NoError(t, err)
+
+	mock := new(testing.T)
+	target.True(t, target.ProtoEqual(mock, expected, actual))
+}
+
+func TestProtoEqual_DifferentMessages(t *testing.T) {
+	t.Parallel()
+
+	mock := new(mockT)
+	target.False(t, target.ProtoEqual(mock, wrapperspb.String("hello"), wrapperspb.String("world")))
+	target.Contains(t, mock.errorString(), "Not equal")
+	target.Contains(t, mock.errorString(), "Diff:")
+}
+
+func TestProtoEqual_DifferentTypes(t *testing.T) {
+	t.Parallel()
+
+	mock := new(testing.T)
+	target.False(t, target.ProtoEqual(mock, wrapperspb.String("1"), wrapperspb.Int64(1)))
+}
+
+func TestProtoEqual_NilMessages(t *testing.T) {
+	t.Parallel()
+
+	mock := new(testing.T)
+	target.True(t, target.ProtoEqual(mock, nil, nil))
+	target.False(t, target.ProtoEqual(mock, wrapperspb.String(""), nil))
+}
+
+func TestProtoEqual_NotAMessage(t *testing.T) {
+	t.Parallel()
+
+	mock := new(mockT)
+	target.False(t, target.ProtoEqual(mock, "hello", wrapperspb.String("hello")))
+	target.Contains(t, mock.errorString(), "expected a protobuf message, but got string")
+}
+
+func TestProtoEqual_UnknownFields(t *testing.T) {
+	t.Parallel()
+
+	expected := wrapperspb.String("hello")
+	actual := withUnknownField(wrapperspb.String("hello"))
+
+	mock := new(testing.T)
+	target.False(t, target.ProtoEqual(mock, expected, actual))
+	target.True(t, target.ProtoEqualIgnoringUnknown(mock, expected, actual))
+
+	// the compared messages are not altered
+	target.NotEmpty(t, actual.ProtoReflect().GetUnknown())
+}
+
+func TestProtoEqual_NestedUnknownFields(t *testing.T) {
+	t.Parallel()
+
+	expected, err := structpb.NewStruct(map[string]any{"name": "x", "list": []any{map[string]any{"a": 1}}})
+	target.NoError(t, err)
+	actual := proto.Clone(expected).(*structpb.Struct) //nolint:forcetypeassert // a clone has the same type
+	withUnknownField(actual.GetFields()["name"])
+	withUnknownField(actual.GetFields()["list"].GetListValue().GetValues()[0])
+
+	mock := new(testing.T)
+	target.False(t, target.ProtoEqual(mock, expected, actual))
+	target.True(t, target.ProtoEqualIgnoringUnknown(mock, expected, actual))
+	target.False(t, target.ProtoEqualIgnoringUnknown(mock, expected, wrapperspb.String("x")))
+}
+
+func withUnknownField[M proto.Message](m M) M {
+	var unknown []byte
+	unknown = protowire.AppendTag(unknown, 999, protowire.VarintType)
+	unknown = protowire.AppendVarint(unknown, 42)
+	m.ProtoReflect().SetUnknown(unknown)
+
+	return m
+}
+
+type mockT struct {
+	errorFmt string
+	args     []any
+}
+
+func (m *mockT) Errorf(format string, args ...any) {
+	m.errorFmt = format
+	m.args = args
+}
+
+func (m *mockT) errorString() string {
+	return fmt.Sprintf(m.errorFmt, m.args...)
+}
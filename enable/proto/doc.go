@@ -0,0 +1,19 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package proto enables the [ProtoEqual] capability in testify.
+//
+// Messages are compared with [proto.Equal] semantics, instead of [reflect.DeepEqual],
+// which reports false failures on the internal state of generated protobuf structs.
+//
+// # Usage
+//
+// To enable protobuf assertion features, use a blank import like so:
+//
+//	import (
+//			_ "github.com/go-openapi/testify/enable/proto/v2"
+//	)
+//
+// [ProtoEqual]: https://pkg.go.dev/github.com/go-openapi/testify/v2/assert#ProtoEqual
+// [proto.Equal]: https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+package proto
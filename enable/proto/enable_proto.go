@@ -0,0 +1,104 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package proto
+
+import (
+	"fmt"
+
+	protostub "github.com/go-openapi/testify/v2/enable/stubs/proto"
+
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func init() { //nolint:gochecknoinits // we precisely want this init to run when importing the package
+	protostub.EnableProtoWithEqual(equal)
+	protostub.EnableProtoWithFormat(format)
+}
+
+func equal(expected, actual any, ignoreUnknownFields bool) (bool, error) {
+	e, err := asMessage(expected)
+	if err != nil {
+		return false, err
+	}
+
+	a, err := asMessage(actual)
+	if err != nil {
+		return false, err
+	}
+
+	if ignoreUnknownFields {
+		e = withoutUnknownFields(e)
+		a = withoutUnknownFields(a)
+	}
+
+	return proto.Equal(e, a), nil
+}
+
+func format(msg any) (string, error) {
+	m, err := asMessage(msg)
+	if err != nil {
+		return "", err
+	}
+
+	return prototext.MarshalOptions{Multiline: true, EmitUnknown: true}.Format(m), nil
+}
+
+func asMessage(value any) (proto.Message, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	m, ok := value.(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("expected a protobuf message, but got %T", value)
+	}
+
+	return m, nil
+}
+
+// withoutUnknownFields returns a copy of the message with unknown fields removed at any nesting level.
+func withoutUnknownFields(m proto.Message) proto.Message {
+	if m == nil || !m.ProtoReflect().IsValid() {
+		return m
+	}
+
+	clone := proto.Clone(m)
+	discardUnknown(clone.ProtoReflect())
+
+	return clone
+}
+
+func discardUnknown(m protoreflect.Message) {
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			if fd.Message() == nil {
+				break
+			}
+			list := v.List()
+			for i := range list.Len() {
+				discardUnknown(list.Get(i).Message())
+			}
+		case fd.IsMap():
+			if fd.MapValue().Message() == nil {
+				break
+			}
+			v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+				discardUnknown(mv.Message())
+
+				return true
+			})
+		case fd.Message() != nil:
+			discardUnknown(v.Message())
+		}
+
+		return true
+	})
+
+	if m.GetUnknown() != nil {
+		m.SetUnknown(nil)
+	}
+}
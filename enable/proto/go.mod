@@ -0,0 +1,10 @@
+module github.com/go-openapi/testify/enable/proto/v2
+
+require (
+	github.com/go-openapi/testify/v2 v2.5.1
+	google.golang.org/protobuf v1.36.11
+)
+
+replace github.com/go-openapi/testify/v2 => ../..
+
+go 1.25.0
@@ -10,6 +10,7 @@
 //
 //   - yaml: API for enabling YAML assertions
 //   - colors: API for enabling colorized output
+//   - proto: API for enabling protobuf assertions
 //
-// These stubs are used by the enable/{yaml,colors} modules to activate optional features.
+// These stubs are used by the enable/{yaml,colors,proto} modules to activate optional features.
 package stubs
@@ -0,0 +1,38 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package proto is an indirection to the internal implementation that handles protobuf message comparison.
+//
+// This package allows the builder to override the indirection with an alternative implementation
+// of protobuf equality.
+package proto
+
+import (
+	protostub "github.com/go-openapi/testify/v2/internal/assertions/enable/proto"
+)
+
+// EnableProtoWithEqual registers a protobuf-aware equality function.
+//
+// The registered function must return an error if expected or actual are not protobuf messages.
+// When ignoreUnknownFields is true, unknown fields must not be compared.
+//
+// This is not intended for concurrent use.
+//
+// Most users would register using a init() function or enabling the
+// registered library provided when importing "github.com/go-openapi/testify/enable/proto/v2" like so.
+//
+// The default registration uses [google.golang.org/protobuf/proto.Equal] to compare messages.
+//
+//	import(
+//		_ "github.com/go-openapi/testify/enable/proto/v2"
+//	)
+func EnableProtoWithEqual(equal func(expected, actual any, ignoreUnknownFields bool) (bool, error)) {
+	protostub.EnableProtoWithEqual(equal)
+}
+
+// EnableProtoWithFormat registers a function to render protobuf messages as text in failure reports.
+//
+// See [EnableProtoWithEqual].
+func EnableProtoWithFormat(format func(any) (string, error)) {
+	protostub.EnableProtoWithFormat(format)
+}
@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package proto
+
+import (
+	"fmt"
+	"testing"
+
+	target "github.com/go-openapi/testify/v2/assert"
+)
+
+// TestEnableProto is merely a smoke test to validate that the chain of calls is resolved properly.
+func TestEnableProto(t *testing.T) {
+	t.Parallel()
+
+	EnableProtoWithEqual(func(_, _ any, _ bool) (bool, error) {
+		return false, fmt.Errorf("called: %w", target.ErrTest)
+	})
+	EnableProtoWithFormat(func(_ any) (string, error) {
+		return "", fmt.Errorf("called: %w", target.ErrTest)
+	})
+
+	mock := new(testing.T)
+	target.False(t, target.ProtoEqual(mock, "a", "a"))
+	target.False(t, target.ProtoEqualIgnoringUnknown(mock, "a", "a"))
+}
@@ -48,6 +48,90 @@ func Len(t T, object any, length int, msgAndArgs ...any) bool {
 	return true
 }
 
+// SliceLenT asserts that the specified slice has a specific length.
+//
+// Unlike [Len], the length is obtained without reflection.
+//
+// # Usage
+//
+//	assertions.SliceLenT(t, []string{"Hello","World"}, 2)
+//
+// # Examples
+//
+//	success: []string{"A","B"}, 2
+//	failure: []string{"A","B"}, 1
+func SliceLenT[Slice ~[]E, E any](t T, s Slice, length int, msgAndArgs ...any) bool {
+	// Domain: collection
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if l := len(s); l != length {
+		return Fail(t, fmt.Sprintf("%q should have %d item(s), but has %d", truncatingFormat("%v", s), length, l), msgAndArgs...)
+	}
+
+	return true
+}
+
+// MapLenT asserts that the specified map has a specific number of keys.
+//
+// Unlike [Len], the length is obtained without reflection.
+//
+// # Usage
+//
+//	assertions.MapLenT(t, map[string]string{"Hello": "x","World": "y"}, 2)
+//
+// # Examples
+//
+//	success: map[string]string{"A": "B"}, 1
+//	failure: map[string]string{"A": "B"}, 2
+func MapLenT[Map ~map[K]V, K comparable, V any](t T, m Map, length int, msgAndArgs ...any) bool {
+	// Domain: collection
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if l := len(m); l != length {
+		return Fail(t, fmt.Sprintf("%q should have %d item(s), but has %d", truncatingFormat("%v", m), length, l), msgAndArgs...)
+	}
+
+	return true
+}
+
+// SeqLenT asserts that the specified iterator yields a specific number of elements.
+//
+// The sequence is consumed entirely, unless it yields more elements than expected:
+// in that case, the iteration stops as soon as the expected length is exceeded.
+//
+// # Usage
+//
+//	assertions.SeqLenT(t, slices.Values([]string{"Hello","World"}), 2)
+//
+// # Examples
+//
+//	success: slices.Values([]string{"A","B"}), 2
+//	failure: slices.Values([]string{"A","B"}), 1
+func SeqLenT[E any](t T, iter iter.Seq[E], length int, msgAndArgs ...any) bool {
+	// Domain: collection
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	var l int
+	for range iter {
+		l++
+		if l > length {
+			return Fail(t, fmt.Sprintf("sequence should have %d item(s), but has more", length), msgAndArgs...)
+		}
+	}
+
+	if l != length {
+		return Fail(t, fmt.Sprintf("sequence should have %d item(s), but has %d", length, l), msgAndArgs...)
+	}
+
+	return true
+}
+
 // Contains asserts that the specified string, list(array, slice...) or map contains the
 // specified substring or element.
 //
@@ -424,13 +508,18 @@ func SliceSubsetT[Slice ~[]E, E comparable](t T, list, subset Slice, msgAndArgs
 		h.Helper()
 	}
 
+	var missing []any
 	for _, element := range subset {
 		if !slices.Contains(list, element) {
-			return Fail(t, fmt.Sprintf("%s does not contain %#v", truncatingFormat("%#v", list), element), msgAndArgs...)
+			missing = append(missing, element)
 		}
 	}
 
-	return true
+	if len(missing) == 0 {
+		return true
+	}
+
+	return Fail(t, formatMissingElements(list, missing), msgAndArgs...)
 }
 
 // NotSubset asserts that the list (array, slice, or map) does NOT contain all
@@ -776,19 +865,32 @@ func MapNotEqualT[K, V comparable](t T, listA, listB map[K]V, msgAndArgs ...any)
 }
 
 func isSubsetMap(t T, list, subset any, subsetMap, actualMap reflect.Value, msgAndArgs ...any) bool {
+	var mismatches []string
 	for _, k := range subsetMap.MapKeys() {
 		ev := subsetMap.MapIndex(k)
 		av := actualMap.MapIndex(k)
 
 		if !av.IsValid() {
-			return Fail(t, fmt.Sprintf("%s does not contain %s", truncatingFormat("%#v", list), truncatingFormat("%#v", subset)), msgAndArgs...)
+			mismatches = append(mismatches, fmt.Sprintf("key %s: missing", truncatingFormat("%#v", k.Interface())))
+
+			continue
 		}
 		if !ObjectsAreEqual(ev.Interface(), av.Interface()) {
-			return Fail(t, fmt.Sprintf("%s does not contain %s", truncatingFormat("%#v", list), truncatingFormat("%#v", subset)), msgAndArgs...)
+			mismatches = append(mismatches, fmt.Sprintf("key %s: expected %s, actual %s",
+				truncatingFormat("%#v", k.Interface()), truncatingFormat("%#v", ev.Interface()), truncatingFormat("%#v", av.Interface()),
+			))
 		}
 	}
 
-	return true
+	if len(mismatches) == 0 {
+		return true
+	}
+
+	slices.Sort(mismatches) // map iteration order is random
+
+	return Fail(t, fmt.Sprintf("%s does not contain %s:\n  - %s",
+		truncatingFormat("%#v", list), truncatingFormat("%#v", subset), strings.Join(mismatches, "\n  - "),
+	), msgAndArgs...)
 }
 
 func isNotSubsetMap(t T, list, subset any, subsetMap, actualMap reflect.Value, msgAndArgs ...any) bool {
@@ -809,15 +911,20 @@ func isNotSubsetMap(t T, list, subset any, subsetMap, actualMap reflect.Value, m
 }
 
 func isSubsetList(t T, list any, subsetList reflect.Value, msgAndArgs ...any) bool {
+	var missing []any
 	for i := range subsetList.Len() {
 		element := subsetList.Index(i).Interface()
 		_, found := containsElement(list, element) // containsElement will work for this type: no need to check the ok bool
 		if !found {
-			return Fail(t, fmt.Sprintf("%s does not contain %#v", truncatingFormat("%#v", list), element), msgAndArgs...)
+			missing = append(missing, element)
 		}
 	}
 
-	return true
+	if len(missing) == 0 {
+		return true
+	}
+
+	return Fail(t, formatMissingElements(list, missing), msgAndArgs...)
 }
 
 func isNotSubsetList(t T, list, subset any, subsetList reflect.Value, msgAndArgs ...any) bool {
@@ -961,26 +1068,71 @@ func diffListsT[E comparable](listA, listB []E) (extraA, extraB []any) {
 	return extraA, extraB
 }
 
+// formatListDiff reports the elements found only in list A and only in list B.
+//
+// Duplicate elements are reported once, with the number of unmatched occurrences.
 func formatListDiff(listA, listB any, extraA, extraB []any) string {
 	var msg bytes.Buffer
 
-	msg.WriteString("elements differ")
+	fmt.Fprintf(&msg, "elements differ: list A has %d element(s), list B has %d element(s)",
+		reflect.ValueOf(listA).Len(), reflect.ValueOf(listB).Len(),
+	)
 	if len(extraA) > 0 {
-		msg.WriteString("\n\nextra elements in list A:\n")
-		msg.WriteString(dumper(extraA))
+		msg.WriteString("\n\nmissing from list B (found in list A only):")
+		writeElementCounts(&msg, extraA)
 	}
 	if len(extraB) > 0 {
-		msg.WriteString("\n\nextra elements in list B:\n")
-		msg.WriteString(dumper(extraB))
+		msg.WriteString("\n\nunexpected in list B (not found in list A):")
+		writeElementCounts(&msg, extraB)
+	}
+
+	return msg.String()
+}
+
+// formatMissingElements reports the elements of a subset that are not found in list.
+func formatMissingElements(list any, missing []any) string {
+	if len(missing) == 1 {
+		return fmt.Sprintf("%s does not contain %#v", truncatingFormat("%#v", list), missing[0])
 	}
-	msg.WriteString("\n\nlistA:\n")
-	msg.WriteString(dumper(listA))
-	msg.WriteString("\n\nlistB:\n")
-	msg.WriteString(dumper(listB))
+
+	var msg bytes.Buffer
+	fmt.Fprintf(&msg, "%s does not contain %d elements from the subset:", truncatingFormat("%#v", list), len(missing))
+	writeElementCounts(&msg, missing)
 
 	return msg.String()
 }
 
+type elementCount struct {
+	element any
+	count   int
+}
+
+// writeElementCounts writes one line per distinct element, in order of first appearance.
+func writeElementCounts(w *bytes.Buffer, elements []any) {
+	counts := make([]elementCount, 0, len(elements))
+
+NEXT:
+	for _, element := range elements {
+		for i := range counts {
+			if ObjectsAreEqual(counts[i].element, element) {
+				counts[i].count++
+
+				continue NEXT
+			}
+		}
+
+		counts = append(counts, elementCount{element: element, count: 1})
+	}
+
+	for _, c := range counts {
+		w.WriteString("\n  - ")
+		w.WriteString(truncatingFormat("%#v", c.element))
+		if c.count > 1 {
+			fmt.Fprintf(w, " (%d occurrences)", c.count)
+		}
+	}
+}
+
 // getLen tries to get the length of an object.
 //
 // It returns (0, false) if impossible.
@@ -22,6 +22,15 @@ func TestCollectionLen(t *testing.T) {
 	}
 }
 
+// TestCollectionLenT tests the generic SliceLenT, MapLenT and SeqLenT assertions.
+func TestCollectionLenT(t *testing.T) {
+	t.Parallel()
+
+	for tc := range collectionLenTCases() {
+		t.Run(tc.name, tc.test)
+	}
+}
+
 // TestCollectionContains tests both Contains and NotContains with reflection-based
 // and generic variants using unified test cases.
 //
@@ -264,6 +273,101 @@ func collectionLenCases() iter.Seq[collectionLenCase] {
 	})
 }
 
+// ============================================================================
+// TestCollectionLenT
+// ============================================================================
+
+func collectionLenTCases() iter.Seq[genericTestCase] {
+	type myInts []int
+	type myMap map[string]int
+
+	return slices.Values([]genericTestCase{
+		{"slice/int", testSliceLenT([]int{1, 2, 3}, 3)},
+		{"slice/string", testSliceLenT([]string{"A", "B"}, 2)},
+		{"slice/custom", testSliceLenT(myInts{1, 2}, 2)},
+		{"slice/empty", testSliceLenT([]int{}, 0)},
+		{"slice/nil", testSliceLenT([]int(nil), 0)},
+		{"map/int", testMapLenT(map[int]int{1: 2, 2: 4, 3: 6}, 3)},
+		{"map/custom", testMapLenT(myMap{"A": 1}, 1)},
+		{"map/nil", testMapLenT(map[int]int(nil), 0)},
+		{"seq/int", testSeqLenT([]int{1, 2, 3}, 3)},
+		{"seq/empty", testSeqLenT([]string{}, 0)},
+	})
+}
+
+func testSliceLenT[Slice ~[]E, E any](s Slice, length int) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		if !SliceLenT(mock, s, length) {
+			t.Errorf("%#v should have %d items", s, length)
+		}
+
+		mock = new(mockT)
+		if SliceLenT(mock, s, length+1) {
+			t.Errorf("%#v should not have %d items", s, length+1)
+		}
+		expected := fmt.Sprintf("should have %d item(s), but has %d", length+1, length)
+		if !strings.Contains(mock.errorString(), expected) {
+			t.Errorf("expected error message to contain %q but got: %q", expected, mock.errorString())
+		}
+	}
+}
+
+func testMapLenT[Map ~map[K]V, K comparable, V any](m Map, length int) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		if !MapLenT(mock, m, length) {
+			t.Errorf("%#v should have %d items", m, length)
+		}
+
+		mock = new(mockT)
+		if MapLenT(mock, m, length+1) {
+			t.Errorf("%#v should not have %d items", m, length+1)
+		}
+		expected := fmt.Sprintf("should have %d item(s), but has %d", length+1, length)
+		if !strings.Contains(mock.errorString(), expected) {
+			t.Errorf("expected error message to contain %q but got: %q", expected, mock.errorString())
+		}
+	}
+}
+
+func testSeqLenT[E any](values []E, length int) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		if !SeqLenT(mock, slices.Values(values), length) {
+			t.Errorf("sequence %#v should have %d items", values, length)
+		}
+
+		mock = new(mockT)
+		if SeqLenT(mock, slices.Values(values), length+1) {
+			t.Errorf("sequence %#v should not have %d items", values, length+1)
+		}
+		expected := fmt.Sprintf("sequence should have %d item(s), but has %d", length+1, length)
+		if !strings.Contains(mock.errorString(), expected) {
+			t.Errorf("expected error message to contain %q but got: %q", expected, mock.errorString())
+		}
+
+		if length == 0 {
+			return
+		}
+
+		mock = new(mockT)
+		if SeqLenT(mock, slices.Values(values), length-1) {
+			t.Errorf("sequence %#v should not have %d items", values, length-1)
+		}
+		expected = fmt.Sprintf("sequence should have %d item(s), but has more", length-1)
+		if !strings.Contains(mock.errorString(), expected) {
+			t.Errorf("expected error message to contain %q but got: %q", expected, mock.errorString())
+		}
+	}
+}
+
 // ============================================================================
 // TestCollectionContains
 // ============================================================================
@@ -1215,6 +1319,48 @@ func collectionErrorMessageCases() iter.Seq[failCase] {
 			wantContains: []string{pkg + `.nonContainer{Value:"Hello"} could not be applied builtin len()`},
 		},
 
+		// ElementsMatch/Subset breakdown of missing and unexpected elements
+		{
+			name: "ElementsMatch(missing-and-unexpected)",
+			assertion: func(t T) bool {
+				return ElementsMatch(t, []string{"a", "b", "b", "c"}, []string{"a", "d", "c"})
+			},
+			wantContains: []string{
+				"elements differ: list A has 4 element(s), list B has 3 element(s)",
+				"missing from list B (found in list A only):\n  - \"b\" (2 occurrences)",
+				"unexpected in list B (not found in list A):\n  - \"d\"",
+			},
+		},
+		{
+			name: "ElementsMatchT(unexpected-only)",
+			assertion: func(t T) bool {
+				return ElementsMatchT(t, []int{1, 2}, []int{1, 2, 3, 3})
+			},
+			wantContains: []string{
+				"unexpected in list B (not found in list A):\n  - 3 (2 occurrences)",
+			},
+		},
+		{
+			name:         "Subset(several-missing)",
+			assertion:    func(t T) bool { return Subset(t, []int{1, 2, 3}, []int{1, 4, 5}) },
+			wantContains: []string{"[]int{1, 2, 3} does not contain 2 elements from the subset:", "  - 4", "  - 5"},
+		},
+		{
+			name:         "SliceSubsetT(several-missing)",
+			assertion:    func(t T) bool { return SliceSubsetT(t, []int{1, 2, 3}, []int{1, 4, 5}) },
+			wantContains: []string{"[]int{1, 2, 3} does not contain 2 elements from the subset:", "  - 4", "  - 5"},
+		},
+		{
+			name: "Subset(map-missing-and-mismatched)",
+			assertion: func(t T) bool {
+				return Subset(t, map[string]int{"x": 1, "y": 2}, map[string]int{"x": 2, "z": 3})
+			},
+			wantContains: []string{
+				`key "x": expected 2, actual 1`,
+				`key "z": missing`,
+			},
+		},
+
 		// nil container
 		{
 			name:         "Contains(nil, key)",
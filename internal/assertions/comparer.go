@@ -0,0 +1,284 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+// Comparer is a custom equality function for values of a given type.
+//
+// Comparers are built with [CompareWith] and configured for a test with [WithComparers].
+type Comparer struct {
+	typ   reflect.Type
+	equal func(expected, actual any) bool
+}
+
+// CompareWith builds a [Comparer] that decides whether two values of type V are equal.
+//
+// The comparer applies to all values of type V found in the compared objects, at any depth,
+// including values held by unexported struct fields.
+// When V is an interface type, the comparer applies to all values implementing V.
+//
+// This allows a test to plug in domain-specific equality, e.g. for [time.Time] truncation,
+// decimal types or protobuf messages, or to delegate the comparison to another library
+// such as github.com/google/go-cmp:
+//
+//	assert.CompareWith(func(expected, actual time.Time) bool {
+//		return expected.Truncate(time.Second).Equal(actual.Truncate(time.Second))
+//	})
+//
+//	assert.CompareWith(func(expected, actual any) bool {
+//		return cmp.Equal(expected, actual, cmpopts.EquateEmpty())
+//	})
+func CompareWith[V any](equal func(expected, actual V) bool) Comparer {
+	return Comparer{
+		typ: reflect.TypeFor[V](),
+		equal: func(expected, actual any) bool {
+			e, _ := expected.(V) // nil interface values are passed as the zero value of V
+			a, _ := actual.(V)
+
+			return equal(e, a)
+		},
+	}
+}
+
+// WithComparers configures custom comparers used by [Equal], [NotEqual], [EqualValues] and [NotEqualValues].
+//
+// Comparers apply to all assertions called with the same [T], until the test completes.
+// They are not inherited by subtests.
+//
+// When several comparers apply to the same type, the first one configured wins.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithComparers(
+//		assert.CompareWith(func(expected, actual *big.Int) bool { return expected.Cmp(actual) == 0 }),
+//	))
+//	a.Equal(big.NewInt(1), big.NewInt(1))
+func WithComparers(comparers ...Comparer) Option {
+	return func(t T) {
+		if len(comparers) == 0 {
+			return
+		}
+
+		configure(t, func(cfg *testConfig) {
+			cfg.comparers = append(cfg.comparers, comparers...)
+		})
+	}
+}
+
+// objectsAreEqualFor is like [ObjectsAreEqual], using the custom comparers configured for t, if any.
+func objectsAreEqualFor(t T, expected, actual any) bool {
+	engine, ok := comparersFor(t)
+	if !ok {
+		return ObjectsAreEqual(expected, actual)
+	}
+
+	return engine.equal(expected, actual)
+}
+
+// objectsAreEqualValuesFor is like [ObjectsAreEqualValues], using the custom comparers configured for t, if any.
+//
+// Like [ObjectsAreEqualValues], values of different types are compared after conversion,
+// with the comparers applied to the converted values.
+func objectsAreEqualValuesFor(t T, expected, actual any) bool {
+	engine, ok := comparersFor(t)
+	if !ok {
+		return ObjectsAreEqualValues(expected, actual)
+	}
+
+	if engine.equal(expected, actual) {
+		return true
+	}
+
+	expectedValue := reflect.ValueOf(expected)
+	actualValue := reflect.ValueOf(actual)
+	if !expectedValue.IsValid() || !actualValue.IsValid() {
+		return false
+	}
+
+	expectedType := expectedValue.Type()
+	actualType := actualValue.Type()
+	if expectedType == actualType || !expectedValue.CanConvert(actualType) {
+		return false
+	}
+
+	// like [ObjectsAreEqualValues], numeric values are converted to the larger type
+	if isNumericType(expectedType) && isNumericType(actualType) && expectedType.Size() >= actualType.Size() {
+		return engine.equal(expected, actualValue.Convert(expectedType).Interface())
+	}
+
+	return engine.equal(expectedValue.Convert(actualType).Interface(), actual)
+}
+
+func comparersFor(t T) (*comparisonEngine, bool) {
+	cfg, ok := configFor(t)
+	if !ok || len(cfg.comparers) == 0 {
+		return nil, false
+	}
+
+	return &comparisonEngine{
+		comparers: cfg.comparers,
+		visited:   make(map[visit]bool),
+	}, true
+}
+
+// comparisonEngine performs a deep equality check similar to [reflect.DeepEqual],
+// with custom comparers applied to the values of the types they support.
+type comparisonEngine struct {
+	comparers []Comparer
+	visited   map[visit]bool
+}
+
+// visit records pointers already compared, to support cyclic data structures.
+type visit struct {
+	x, y unsafe.Pointer
+	typ  reflect.Type
+}
+
+func (e *comparisonEngine) equal(expected, actual any) bool {
+	if expected == nil || actual == nil {
+		return expected == actual
+	}
+
+	return e.deepEqual(reflect.ValueOf(expected), reflect.ValueOf(actual))
+}
+
+// exportedField returns the i-th field of a struct, such that comparers may be called on unexported fields.
+//
+// Values obtained from unexported fields cannot be passed to [reflect.Value.Interface]: the field is read
+// from an addressable copy of the struct instead.
+func exportedField(v reflect.Value, i int) reflect.Value {
+	field := v.Field(i)
+	if field.CanInterface() {
+		return field
+	}
+
+	return reflect.NewAt(field.Type(), unsafe.Pointer(field.UnsafeAddr())).Elem()
+}
+
+// addressable returns v, or an addressable copy of v.
+func addressable(v reflect.Value) reflect.Value {
+	if v.CanAddr() {
+		return v
+	}
+
+	copied := reflect.New(v.Type()).Elem()
+	copied.Set(v)
+
+	return copied
+}
+
+// comparerFor returns the first comparer supporting both types: either the exact type of the comparer,
+// or any two types implementing the interface type of the comparer.
+func (e *comparisonEngine) comparerFor(x, y reflect.Type) (Comparer, bool) {
+	for _, comparer := range e.comparers {
+		if x == comparer.typ && y == comparer.typ {
+			return comparer, true
+		}
+
+		if comparer.typ.Kind() == reflect.Interface && x.Implements(comparer.typ) && y.Implements(comparer.typ) {
+			return comparer, true
+		}
+	}
+
+	return Comparer{}, false
+}
+
+func (e *comparisonEngine) deepEqual(x, y reflect.Value) bool {
+	if !x.IsValid() || !y.IsValid() {
+		return x.IsValid() == y.IsValid()
+	}
+
+	if comparer, ok := e.comparerFor(x.Type(), y.Type()); ok {
+		return comparer.equal(x.Interface(), y.Interface())
+	}
+
+	if x.Type() != y.Type() {
+		return false
+	}
+
+	switch x.Kind() {
+	case reflect.Pointer:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+
+		if x.UnsafePointer() == y.UnsafePointer() {
+			return true
+		}
+
+		v := visit{x: x.UnsafePointer(), y: y.UnsafePointer(), typ: x.Type()}
+		if e.visited[v] {
+			return true
+		}
+		e.visited[v] = true
+
+		return e.deepEqual(x.Elem(), y.Elem())
+
+	case reflect.Interface:
+		if x.IsNil() || y.IsNil() {
+			return x.IsNil() == y.IsNil()
+		}
+
+		return e.deepEqual(x.Elem(), y.Elem())
+
+	case reflect.Struct:
+		x, y = addressable(x), addressable(y)
+		for i := range x.NumField() {
+			if !e.deepEqual(exportedField(x, i), exportedField(y, i)) {
+				return false
+			}
+		}
+
+		return true
+
+	case reflect.Slice:
+		if x.IsNil() != y.IsNil() || x.Len() != y.Len() {
+			return false
+		}
+
+		fallthrough
+
+	case reflect.Array:
+		for i := range x.Len() {
+			if !e.deepEqual(x.Index(i), y.Index(i)) {
+				return false
+			}
+		}
+
+		return true
+
+	case reflect.Map:
+		if x.IsNil() != y.IsNil() || x.Len() != y.Len() {
+			return false
+		}
+
+		iter := x.MapRange()
+		for iter.Next() {
+			yValue := y.MapIndex(iter.Key())
+			if !yValue.IsValid() || !e.deepEqual(iter.Value(), yValue) {
+				return false
+			}
+		}
+
+		return true
+
+	case reflect.Func:
+		// like [reflect.DeepEqual], functions are only equal if both are nil
+		return x.IsNil() && y.IsNil()
+
+	case reflect.Bool, reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64, reflect.Complex64, reflect.Complex128,
+		reflect.String, reflect.Chan, reflect.UnsafePointer:
+		return x.Equal(y)
+
+	default:
+		panic(fmt.Sprintf("internal error: unexpected kind %v", x.Kind()))
+	}
+}
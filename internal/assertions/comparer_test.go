@@ -0,0 +1,413 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"iter"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestComparerEqual(t *testing.T) {
+	t.Parallel()
+
+	for tc := range comparerCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("with Equal", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithComparers(tc.comparers...)(mock)
+				res := Equal(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, tc.equal)
+			})
+
+			t.Run("with NotEqual", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithComparers(tc.comparers...)(mock)
+				res := NotEqual(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, !tc.equal)
+			})
+
+			t.Run("with EqualValues", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithComparers(tc.comparers...)(mock)
+				res := EqualValues(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, tc.equal)
+			})
+
+			t.Run("with NotEqualValues", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithComparers(tc.comparers...)(mock)
+				res := NotEqualValues(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, !tc.equal)
+			})
+		})
+	}
+}
+
+func TestComparerScope(t *testing.T) {
+	t.Parallel()
+
+	truncated := CompareWith(func(expected, actual time.Time) bool {
+		return expected.Truncate(time.Second).Equal(actual.Truncate(time.Second))
+	})
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Millisecond)
+
+	t.Run("comparers are scoped to their T", func(t *testing.T) {
+		t.Parallel()
+
+		configured := new(mockT)
+		WithComparers(truncated)(configured)
+		other := new(mockT)
+
+		if !Equal(configured, now, later) {
+			t.Error("expected the configured comparer to apply")
+		}
+		if Equal(other, now, later) {
+			t.Error("expected comparers not to leak to another T")
+		}
+	})
+
+	t.Run("comparers are removed when the test completes", func(t *testing.T) {
+		t.Parallel()
+
+		var sub *testing.T
+		t.Run("sub", func(t *testing.T) {
+			sub = t
+			WithComparers(truncated)(t)
+
+			if _, ok := comparersFor(t); !ok {
+				t.Error("expected comparers to be registered")
+			}
+		})
+
+		if _, ok := comparersFor(sub); ok {
+			t.Error("expected comparers to be removed on cleanup")
+		}
+	})
+
+	t.Run("comparers accumulate", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		WithComparers(truncated)(mock)
+		WithComparers(CompareWith(strings.EqualFold))(mock)
+
+		if !Equal(mock, []any{now, "A"}, []any{later, "a"}) {
+			t.Error("expected both comparers to apply")
+		}
+	})
+
+	t.Run("a non-comparable T is reported", func(t *testing.T) {
+		t.Parallel()
+
+		mock := nonComparableT{errors: new([]string)}
+		WithComparers(truncated)(mock)
+
+		if len(*mock.errors) != 1 || !strings.Contains((*mock.errors)[0], "is not comparable") {
+			t.Errorf("expected an error to be reported, got %v", *mock.errors)
+		}
+	})
+
+	t.Run("a T without Cleanup is reported and not registered", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(errorsCapturingT)
+		WithComparers(truncated)(mock)
+
+		if len(mock.errors) != 1 || !strings.Contains(mock.errors[0].Error(), "does not support Cleanup") {
+			t.Errorf("expected an error to be reported, got %v", mock.errors)
+		}
+		if _, ok := testConfigs.Load(mock); ok {
+			t.Error("expected the configuration not to be registered")
+		}
+	})
+
+	t.Run("comparers are kept on a CollectT", func(t *testing.T) {
+		t.Parallel()
+
+		collector := new(CollectT)
+		WithComparers(truncated)(collector)
+
+		if !Equal(collector, now, later) {
+			t.Error("expected the configured comparer to apply")
+		}
+		if _, ok := testConfigs.Load(collector); ok {
+			t.Error("expected the configuration not to be registered")
+		}
+	})
+}
+
+func TestComparerEqualValues(t *testing.T) {
+	t.Parallel()
+
+	foldCase := CompareWith(strings.EqualFold)
+	never := CompareWith(func(_, _ int64) bool { return false })
+
+	for _, tc := range []struct {
+		name     string
+		comparer Comparer
+		expected any
+		actual   any
+		equal    bool
+	}{
+		{name: "converted-value", comparer: foldCase, expected: comparerString("A"), actual: "a", equal: true},
+		{name: "converted-numeric-value", comparer: never, expected: int32(1), actual: int64(1), equal: false},
+		{name: "not-convertible", comparer: foldCase, expected: 1.5, actual: "a", equal: false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			WithComparers(tc.comparer)(mock)
+			res := EqualValues(mock, tc.expected, tc.actual)
+			shouldPassOrFail(t, mock, res, tc.equal)
+		})
+	}
+}
+
+func TestComparerFailMessage(t *testing.T) {
+	t.Parallel()
+
+	mock := new(captureT)
+	WithComparers(CompareWith(strings.EqualFold))(mock)
+
+	if Equal(mock, comparerRecord{Name: "a", Count: 1}, comparerRecord{Name: "A", Count: 2}) {
+		t.Fatal("expected Equal to fail")
+	}
+
+	for _, want := range []string{"Not equal:", "- Count: (int) 1", "+ Count: (int) 2"} {
+		if !strings.Contains(mock.msg, want) {
+			t.Errorf("expected failure message to contain %q, got:\n%s", want, mock.msg)
+		}
+	}
+}
+
+type comparerRecord struct {
+	Name    string
+	Count   int
+	At      time.Time
+	Amount  *big.Int
+	Tags    map[string]string
+	Items   []fmt.Stringer
+	Next    *comparerRecord
+	private string
+}
+
+type comparerPrivate struct {
+	at    time.Time
+	value any
+}
+
+type comparerStringer string
+
+type comparerString string
+
+func (s comparerStringer) String() string { return string(s) }
+
+type nonComparableT struct {
+	errors *[]string
+	_      []string // makes the type non-comparable
+}
+
+func (m nonComparableT) Errorf(format string, args ...any) {
+	*m.errors = append(*m.errors, fmt.Sprintf(format, args...))
+}
+
+type comparerCase struct {
+	name      string
+	comparers []Comparer
+	expected  any
+	actual    any
+	equal     bool
+}
+
+func comparerCases() iter.Seq[comparerCase] {
+	truncated := CompareWith(func(expected, actual time.Time) bool {
+		return expected.Truncate(time.Second).Equal(actual.Truncate(time.Second))
+	})
+	bigInts := CompareWith(func(expected, actual *big.Int) bool {
+		return expected.Cmp(actual) == 0
+	})
+	stringers := CompareWith(func(expected, actual fmt.Stringer) bool {
+		return expected.String() == actual.String()
+	})
+	foldCase := CompareWith(strings.EqualFold)
+	anything := CompareWith(func(_, _ any) bool {
+		return true
+	})
+
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+	later := now.Add(time.Millisecond)
+	cyclic := func(name string) *comparerRecord {
+		r := &comparerRecord{Name: name}
+		r.Next = r
+
+		return r
+	}
+
+	return slices.Values([]comparerCase{
+		{
+			name:      "top-level/equal-with-comparer",
+			comparers: []Comparer{truncated},
+			expected:  now,
+			actual:    later,
+			equal:     true,
+		},
+		{
+			name:      "top-level/not-equal-with-comparer",
+			comparers: []Comparer{truncated},
+			expected:  now,
+			actual:    now.Add(time.Second),
+			equal:     false,
+		},
+		{
+			name:      "nested/struct-fields",
+			comparers: []Comparer{truncated, bigInts},
+			expected:  comparerRecord{Name: "a", At: now, Amount: big.NewInt(10)},
+			actual:    comparerRecord{Name: "a", At: later, Amount: new(big.Int).SetInt64(10)},
+			equal:     true,
+		},
+		{
+			name:      "nested/other-field-differs",
+			comparers: []Comparer{truncated},
+			expected:  comparerRecord{Name: "a", At: now},
+			actual:    comparerRecord{Name: "b", At: later},
+			equal:     false,
+		},
+		{
+			name:      "nested/unexported-field",
+			comparers: []Comparer{foldCase},
+			expected:  comparerRecord{private: "a"},
+			actual:    comparerRecord{private: "A"},
+			equal:     true,
+		},
+		{
+			name:      "nested/unexported-struct-field",
+			comparers: []Comparer{truncated},
+			expected:  map[string]comparerPrivate{"k": {at: now}},
+			actual:    map[string]comparerPrivate{"k": {at: later}},
+			equal:     true,
+		},
+		{
+			name:      "nested/unexported-interface-field",
+			comparers: []Comparer{truncated},
+			expected:  comparerPrivate{value: []any{now}},
+			actual:    comparerPrivate{value: []any{later}},
+			equal:     true,
+		},
+		{
+			name:      "nested/pointers-maps-and-slices",
+			comparers: []Comparer{foldCase},
+			expected:  &comparerRecord{Tags: map[string]string{"k": "V"}, Next: &comparerRecord{Name: "X"}},
+			actual:    &comparerRecord{Tags: map[string]string{"k": "v"}, Next: &comparerRecord{Name: "x"}},
+			equal:     true,
+		},
+		{
+			name:      "nested/missing-map-key",
+			comparers: []Comparer{foldCase},
+			expected:  map[string]string{"k": "v"},
+			actual:    map[string]string{"K": "v"},
+			equal:     false,
+		},
+		{
+			name:      "nested/different-lengths",
+			comparers: []Comparer{foldCase},
+			expected:  []string{"a"},
+			actual:    []string{"A", "b"},
+			equal:     false,
+		},
+		{
+			name:      "interface/implementations",
+			comparers: []Comparer{stringers},
+			expected:  comparerRecord{Items: []fmt.Stringer{comparerStringer("a"), big.NewInt(1)}},
+			actual:    comparerRecord{Items: []fmt.Stringer{big.NewInt(0).SetInt64(0), comparerStringer("1")}},
+			equal:     false,
+		},
+		{
+			name:      "interface/same-string",
+			comparers: []Comparer{stringers},
+			expected:  []fmt.Stringer{comparerStringer("1")},
+			actual:    []fmt.Stringer{big.NewInt(1)},
+			equal:     true,
+		},
+		{
+			name:      "interface/any-delegates-everything",
+			comparers: []Comparer{anything},
+			expected:  comparerRecord{Name: "a"},
+			actual:    []int{1},
+			equal:     true,
+		},
+		{
+			name:      "first-comparer-wins",
+			comparers: []Comparer{foldCase, CompareWith(func(_, _ string) bool { return false })},
+			expected:  "a",
+			actual:    "A",
+			equal:     true,
+		},
+		{
+			name:      "stricter-than-default",
+			comparers: []Comparer{CompareWith(func(_, _ int) bool { return false })},
+			expected:  1,
+			actual:    1,
+			equal:     false,
+		},
+		{
+			name:      "cyclic",
+			comparers: []Comparer{foldCase},
+			expected:  cyclic("a"),
+			actual:    cyclic("A"),
+			equal:     true,
+		},
+		{
+			name:      "no-comparer-for-type",
+			comparers: []Comparer{foldCase},
+			expected:  []int{1, 2},
+			actual:    []int{1, 2},
+			equal:     true,
+		},
+		{
+			name:      "different-types",
+			comparers: []Comparer{foldCase},
+			expected:  1,
+			actual:    "a",
+			equal:     false,
+		},
+		{
+			name:      "nil-values",
+			comparers: []Comparer{foldCase},
+			expected:  nil,
+			actual:    nil,
+			equal:     true,
+		},
+		{
+			name:      "nil-and-non-nil",
+			comparers: []Comparer{foldCase},
+			expected:  nil,
+			actual:    "a",
+			equal:     false,
+		},
+		{
+			name:      "functions",
+			comparers: []Comparer{foldCase},
+			expected:  []func(){nil},
+			actual:    []func(){nil},
+			equal:     true,
+		},
+	})
+}
@@ -497,6 +497,53 @@ func EventuallyWith[C CollectibleConditioner](t T, condition C, timeout time.Dur
 	return eventuallyWithT(t, condition, timeout, tick, msgAndArgs...)
 }
 
+// EventuallyWithContext asserts that the given condition will be met before the
+// context is done, periodically checking the target function on each tick.
+//
+// [EventuallyWithContext] behaves like [Eventually], except that polling is bounded
+// by the provided context rather than by a fixed timeout. This is convenient for tests
+// which already carry a deadline, e.g. with [testing.T.Context] or a context derived from it.
+//
+// When the condition is never satisfied, the failure reports whether polling stopped
+// because the context deadline was exceeded or because the context was cancelled.
+//
+// A nil context fails the assertion immediately.
+//
+// # Usage
+//
+//	ctx, cancel := context.WithTimeout(t.Context(), 10*time.Second)
+//	defer cancel()
+//
+//	assertions.EventuallyWithContext(t, ctx, func() bool { return true }, 10*time.Millisecond)
+//
+// # Concurrency
+//
+// The condition is executed with the same guarantees as for [Eventually]: serially,
+// by a single goroutine, at least once.
+//
+// When using the func(context.Context) error form, the condition receives a context
+// derived from ctx.
+//
+// # Synctest
+//
+// The [WithSynctest] and [WithSynctestContext] wrappers are accepted, but polling always
+// runs in real time: the provided context lives outside of any [testing/synctest] bubble.
+//
+// See also [Eventually] for details about panic recovery.
+//
+// # Examples
+//
+//	success: context.Background(), func() bool { return true }, 20*time.Millisecond
+//	failure: func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond
+func EventuallyWithContext[C Conditioner](t T, ctx context.Context, condition C, tick time.Duration, msgAndArgs ...any) bool {
+	// Domain: condition
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	return eventuallyWithContext(t, ctx, condition, tick, msgAndArgs...)
+}
+
 func eventually[C Conditioner](t T, condition C, timeout time.Duration, tick time.Duration, msgAndArgs ...any) bool {
 	if h, ok := t.(H); ok {
 		h.Helper()
@@ -511,6 +558,25 @@ func eventually[C Conditioner](t T, condition C, timeout time.Duration, tick tim
 	return runPoller(t, p, cond, timeout, tick, wantsBubble, msgAndArgs...)
 }
 
+func eventuallyWithContext[C Conditioner](t T, ctx context.Context, condition C, tick time.Duration, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if ctx == nil {
+		return Fail(t, "a non-nil context is required", msgAndArgs...)
+	}
+
+	_, cond := makeCondition(condition, false)
+	p := newConditionPoller(pollOptions{
+		mode:        pollUntilTrue,
+		failMessage: "condition never satisfied",
+		parentCtx:   ctx,
+	})
+
+	return p.pollCondition(t, cond, 0, tick, msgAndArgs...)
+}
+
 func never[C NeverConditioner](t T, condition C, timeout time.Duration, tick time.Duration, msgAndArgs ...any) bool {
 	if h, ok := t.(H); ok {
 		h.Helper()
@@ -549,7 +615,7 @@ func eventuallyWithT[C CollectibleConditioner](t T, collectCondition C, timeout
 	wantsBubble, fn := makeCollectibleCondition(collectCondition)
 
 	condition := func(ctx context.Context) (err error) {
-		collector := new(CollectT).withCancelFunc(cancelFunc)
+		collector := new(CollectT).withCancelFunc(cancelFunc).withConfigOf(t)
 
 		defer func() {
 			if r := recover(); r != nil {
@@ -740,6 +806,7 @@ type pollOptions struct {
 	failMessage string              // error message added at the end of the stack
 	onFailure   func(t T)           // called on failure (e.g., to copy collected errors)
 	onSetup     func(cancel func()) // called after context setup to expose cancel function
+	parentCtx   context.Context     // when set, bounds polling instead of the timeout (for EventuallyWithContext)
 }
 
 // pollCondition is the common implementation for eventually, never, and eventuallyWithT.
@@ -842,7 +909,7 @@ func (p *conditionPoller) pollAtTickFunc(parentCtx, ctx context.Context, conditi
 		for {
 			select {
 			case <-ctx.Done():
-				failFunc(ctx.Err().Error())
+				failFunc(p.contextReason(ctx))
 				return
 			case <-p.doneChan:
 				return
@@ -851,7 +918,7 @@ func (p *conditionPoller) pollAtTickFunc(parentCtx, ctx context.Context, conditi
 				// between receiving the tick and attempting to send the condition.
 				select {
 				case <-ctx.Done():
-					failFunc(ctx.Err().Error())
+					failFunc(p.contextReason(ctx))
 					return
 				case <-p.doneChan:
 					return
@@ -902,7 +969,7 @@ func (p *conditionPoller) executeCondition(parentCtx, ctx context.Context, failF
 		for {
 			select {
 			case <-ctx.Done():
-				failFunc(ctx.Err().Error())
+				failFunc(p.contextReason(ctx))
 				return
 			case fn := <-p.conditionChan:
 				var conditionWg sync.WaitGroup
@@ -973,6 +1040,10 @@ func (p *conditionPoller) determineOutcome(parentCtx, ctx context.Context, failF
 }
 
 func (p *conditionPoller) parentContextFromT(t T) context.Context {
+	if p.parentCtx != nil {
+		return p.parentCtx
+	}
+
 	var parentCtx context.Context
 	if withContext, ok := t.(contextualizer); ok {
 		parentCtx = withContext.Context()
@@ -989,6 +1060,11 @@ func (p *conditionPoller) cancellableContext(parentCtx context.Context, timeout
 	// so that timeout reaching is a success, not a failure.
 	var ctx context.Context
 	var cancel context.CancelFunc
+	if p.parentCtx != nil {
+		// For EventuallyWithContext, the caller's context is the only bound.
+		return context.WithCancel(parentCtx)
+	}
+
 	if p.mode == pollUntilTimeout {
 		ctx, cancel = context.WithTimeout(context.WithoutCancel(parentCtx), timeout)
 	} else {
@@ -998,6 +1074,27 @@ func (p *conditionPoller) cancellableContext(parentCtx context.Context, timeout
 	return ctx, cancel
 }
 
+// contextReason explains why polling stopped when ctx is done.
+//
+// With a caller-provided context, the reason tells apart a deadline exceeded
+// from an explicit cancellation.
+func (p *conditionPoller) contextReason(ctx context.Context) string {
+	err := ctx.Err()
+	if p.parentCtx == nil {
+		return err.Error()
+	}
+
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "context deadline exceeded before the condition was satisfied"
+	}
+
+	if cause := context.Cause(ctx); cause != nil && !errors.Is(cause, context.Canceled) {
+		return fmt.Sprintf("context cancelled before the condition was satisfied: %v", cause)
+	}
+
+	return "context cancelled before the condition was satisfied"
+}
+
 // Sentinel errors recorded by async condition assertions.
 // Kept package-private: callers should rely on observable behavior, not on
 // the marker shape. They are distinguishable so future tooling can tell apart
@@ -1011,8 +1108,8 @@ var (
 
 // CollectT implements the [T] interface and collects all errors.
 //
-// [CollectT] is specifically intended to be used with [EventuallyWith] and
-// should not be used outside of that context.
+// [CollectT] is specifically intended to be used with [EventuallyWith] and [Group]
+// and should not be used outside of these contexts.
 type CollectT struct {
 	// Domain: condition
 	//
@@ -1032,6 +1129,12 @@ type CollectT struct {
 
 	// cancelContext cancels the parent EventuallyWith context on Cancel().
 	cancelContext func()
+
+	// exit aborts the evaluation on FailNow() or Cancel(). Defaults to runtime.Goexit.
+	exit func()
+
+	// config holds the options applied to this collector, if any.
+	config *testConfig
 }
 
 // Helper is like [testing.T.Helper] but does nothing.
@@ -1053,7 +1156,7 @@ func (c *CollectT) Errorf(format string, args ...any) {
 // To abort the whole assertion immediately, use [CollectT.Cancel].
 func (c *CollectT) FailNow() {
 	c.errors = append(c.errors, errFailNow)
-	runtime.Goexit()
+	c.abort()
 }
 
 // Cancel records a failure, cancels the [EventuallyWith] context, then exits
@@ -1069,7 +1172,7 @@ func (c *CollectT) FailNow() {
 func (c *CollectT) Cancel() {
 	c.errors = append(c.errors, errCancelled)
 	c.cancelContext()
-	runtime.Goexit()
+	c.abort()
 }
 
 // Cancelf records a failure like [Cancel], with an additional custom message recorded.
@@ -1099,3 +1202,26 @@ func (c *CollectT) withCancelFunc(cancel func()) *CollectT {
 
 	return c
 }
+
+func (c *CollectT) withExitFunc(exit func()) *CollectT {
+	c.exit = exit
+
+	return c
+}
+
+// withConfigOf applies the options configured for t (e.g. comparers) to the collector.
+func (c *CollectT) withConfigOf(t T) *CollectT {
+	if cfg, ok := configFor(t); ok {
+		c.config = &cfg
+	}
+
+	return c
+}
+
+func (c *CollectT) abort() {
+	if c.exit != nil {
+		c.exit()
+	}
+
+	runtime.Goexit()
+}
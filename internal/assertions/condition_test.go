@@ -4,6 +4,7 @@
 package assertions
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"iter"
@@ -163,6 +164,143 @@ func TestConditionEventuallyWith(t *testing.T) {
 			t.Errorf("expected %d errors (1 from condition, 2 from Eventually), got %d", expectedErrors, len(mock.errors))
 		}
 	})
+
+	t.Run("should apply the options of t", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		WithComparers(CompareWith(func(_, _ int) bool { return true }))(mock)
+
+		res := EventuallyWith(mock, func(c *CollectT) {
+			Equal(c, 1, 2)
+		}, testTimeout, time.Millisecond)
+		shouldPassOrFail(t, mock, res, true)
+	})
+}
+
+func TestConditionEventuallyWithContext(t *testing.T) {
+	t.Parallel()
+
+	for c := range eventuallyWithContextCases() {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx, cancel := c.makeContext()
+			defer cancel()
+
+			mock := new(errorsCapturingT)
+			result := EventuallyWithContext(mock, ctx, c.condition, testTick)
+			if result != c.success {
+				t.Errorf("expected EventuallyWithContext to return %t, got %t", c.success, result)
+			}
+
+			if c.success {
+				if len(mock.errors) != 0 {
+					t.Errorf("expected no error, got %v", mock.errors)
+				}
+
+				return
+			}
+
+			if len(mock.errors) == 0 {
+				t.Fatal("expected errors to be reported")
+			}
+
+			if got := mock.errors[0].Error(); got != c.wantReason {
+				t.Errorf("expected failure reason %q, got %q", c.wantReason, got)
+			}
+		})
+	}
+
+	t.Run("with nil context", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(errorsCapturingT)
+		var ctx context.Context
+		if EventuallyWithContext(mock, ctx, func() bool { return true }, testTick) {
+			t.Error("expected EventuallyWithContext to fail with a nil context")
+		}
+	})
+
+	t.Run("should not inherit the context from t", func(t *testing.T) {
+		t.Parallel()
+
+		parentCtx, cancelParent := context.WithCancel(context.Background())
+		cancelParent()
+		mock := new(errorsCapturingT).WithContext(parentCtx)
+
+		if !EventuallyWithContext(mock, context.Background(), func() bool { return true }, testTick) {
+			t.Errorf("expected EventuallyWithContext to ignore the context of t, got %v", mock.errors)
+		}
+	})
+}
+
+type eventuallyWithContextCase struct {
+	name        string
+	makeContext func() (context.Context, context.CancelFunc)
+	condition   func(context.Context) error
+	success     bool
+	wantReason  string
+}
+
+func eventuallyWithContextCases() iter.Seq[eventuallyWithContextCase] {
+	errNotReady := errors.New("not ready")
+	withTimeout := func() (context.Context, context.CancelFunc) {
+		return context.WithTimeout(context.Background(), testTimeout)
+	}
+
+	return slices.Values([]eventuallyWithContextCase{
+		{
+			name:        "should succeed when the condition is met",
+			makeContext: withTimeout,
+			condition:   func(context.Context) error { return nil },
+			success:     true,
+		},
+		{
+			name:        "should succeed after a few ticks",
+			makeContext: withTimeout,
+			condition: func() func(context.Context) error {
+				var calls int
+				return func(context.Context) error {
+					calls++
+					if calls < 3 {
+						return errNotReady
+					}
+
+					return nil
+				}
+			}(),
+			success: true,
+		},
+		{
+			name:        "should fail on deadline exceeded",
+			makeContext: withTimeout,
+			condition:   func(context.Context) error { return errNotReady },
+			wantReason:  "context deadline exceeded before the condition was satisfied",
+		},
+		{
+			name: "should fail on cancellation",
+			makeContext: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancel(context.Background())
+				time.AfterFunc(testTimeout, cancel)
+
+				return ctx, cancel
+			},
+			condition:  func(context.Context) error { return errNotReady },
+			wantReason: "context cancelled before the condition was satisfied",
+		},
+		{
+			name: "should fail on cancellation with a cause",
+			makeContext: func() (context.Context, context.CancelFunc) {
+				ctx, cancel := context.WithCancelCause(context.Background())
+				cancel(errNotReady)
+
+				return ctx, func() { cancel(nil) }
+			},
+			condition:  func(context.Context) error { return errNotReady },
+			wantReason: "context cancelled before the condition was satisfied: not ready",
+		},
+	})
 }
 
 func TestConditionErrorMessages(t *testing.T) {
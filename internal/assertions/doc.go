@@ -25,6 +25,8 @@
 //   - number: asserting numbers
 //   - ordering: asserting how collections are ordered
 //   - panic: asserting a panic behavior
+//   - performance: asserting allocation and timing budgets
+//   - proto: asserting protobuf messages
 //   - safety: checks against leaked resources (goroutines, file descriptors)
 //   - string: asserting strings
 //   - testing: mimics methods from the testing standard library
@@ -0,0 +1,68 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package proto is an indirection to handle protobuf message comparison.
+//
+// This package allows the builder to override the indirection with an alternative implementation
+// of protobuf equality.
+package proto
+
+//nolint:gochecknoglobals // in this particular case, we need a global to enable the feature from another module
+var (
+	enableProtoEqual  func(expected, actual any, ignoreUnknownFields bool) (bool, error)
+	enableProtoFormat func(any) (string, error)
+)
+
+// EnableProtoWithEqual registers a protobuf-aware equality function.
+//
+// The registered function returns an error if expected or actual are not protobuf messages.
+//
+// This is not intended for concurrent use.
+func EnableProtoWithEqual(equal func(expected, actual any, ignoreUnknownFields bool) (bool, error)) {
+	enableProtoEqual = equal
+}
+
+// EnableProtoWithFormat registers a function to render a protobuf message as text.
+//
+// This is not intended for concurrent use.
+func EnableProtoWithFormat(format func(any) (string, error)) {
+	enableProtoFormat = format
+}
+
+// Equal is a wrapper to some external library to compare protobuf messages.
+func Equal(expected, actual any, ignoreUnknownFields bool) (bool, error) {
+	if enableProtoEqual == nil {
+		// fail early and loud
+		panic(`
+protobuf is not enabled yet!
+
+You should enable a protobuf library before running this test,
+e.g. by adding the following to your imports:
+
+import (
+			_ "github.com/go-openapi/testify/enable/proto/v2"
+)
+`,
+		)
+	}
+	return enableProtoEqual(expected, actual, ignoreUnknownFields)
+}
+
+// Format is a wrapper to some external library to render a protobuf message as text.
+func Format(msg any) (string, error) {
+	if enableProtoFormat == nil {
+		// fail early and loud
+		panic(`
+protobuf is not enabled yet!
+
+You should enable a protobuf library before running this test,
+e.g. by adding the following to your imports:
+
+import (
+			_ "github.com/go-openapi/testify/enable/proto/v2"
+)
+`,
+		)
+	}
+	return enableProtoFormat(msg)
+}
@@ -7,6 +7,8 @@ import (
 	"errors"
 	"fmt"
 	"reflect"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/go-openapi/testify/v2/internal/assertions/enable/colors"
@@ -19,6 +21,9 @@ import (
 //
 // Function equality cannot be determined and will always fail.
 //
+// Custom comparers configured with [WithComparers] take precedence for the types they support.
+// Struct fields configured with [WithIgnoredFields] are ignored.
+//
 // # Usage
 //
 //	assertions.Equal(t, 123, 123)
@@ -39,8 +44,9 @@ func Equal(t T, expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if !ObjectsAreEqual(expected, actual) {
-		return failWithDiff(t, expected, actual, msgAndArgs...)
+	expected, actual, ignored := eraseIgnoredFields(t, expected, actual)
+	if !objectsAreEqualFor(t, expected, actual) {
+		return failWithDiffHeader(t, notEqualHeader(ignored), expected, actual, msgAndArgs...)
 	}
 
 	return true
@@ -100,7 +106,8 @@ func NotEqual(t T, expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if ObjectsAreEqual(expected, actual) {
+	expected, actual, _ = eraseIgnoredFields(t, expected, actual)
+	if objectsAreEqualFor(t, expected, actual) {
 		return Fail(t, fmt.Sprintf("Should not be: %s\n", truncatingFormat("%#v", actual)), msgAndArgs...)
 	}
 
@@ -153,12 +160,9 @@ func EqualValues(t T, expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if !ObjectsAreEqualValues(expected, actual) {
-		diff := diff(expected, actual)
-		expected, actual = formatUnequalValues(expected, actual)
-		return Fail(t, fmt.Sprintf("Not equal: \n"+
-			"expected: %s\n"+
-			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
+	expected, actual, ignored := eraseIgnoredFields(t, expected, actual)
+	if !objectsAreEqualValuesFor(t, expected, actual) {
+		return failWithDiffHeader(t, notEqualHeader(ignored), expected, actual, msgAndArgs...)
 	}
 
 	return true
@@ -187,7 +191,8 @@ func NotEqualValues(t T, expected, actual any, msgAndArgs ...any) bool {
 			expected, actual, err), msgAndArgs...)
 	}
 
-	if ObjectsAreEqualValues(expected, actual) {
+	expected, actual, _ = eraseIgnoredFields(t, expected, actual)
+	if objectsAreEqualValuesFor(t, expected, actual) {
 		return Fail(t, fmt.Sprintf("Should not be: %s\n", truncatingFormat("%#v", actual)), msgAndArgs...)
 	}
 
@@ -236,16 +241,87 @@ func EqualExportedValues(t T, expected, actual any, msgAndArgs ...any) bool {
 	actual = copyExportedFields(actual)
 
 	if !ObjectsAreEqualValues(expected, actual) {
-		diff := diff(expected, actual)
-		expected, actual = formatUnequalValues(expected, actual)
-		return Fail(t, fmt.Sprintf("Not equal (comparing only exported fields): \n"+
-			"expected: %s\n"+
-			"actual  : %s%s", expected, actual, diff), msgAndArgs...)
+		return failWithDiffHeader(t, "Not equal (comparing only exported fields)", expected, actual, msgAndArgs...)
+	}
+
+	return true
+}
+
+// EqualIgnoringFields asserts that two objects are equal, ignoring the struct fields with the given names.
+//
+// The ignored fields are zeroed on copies of both objects before comparing them with [Equal].
+// This avoids copying structs around just to reset volatile fields, e.g. identifiers or timestamps.
+//
+// A field name applies to exported struct fields at any depth, including
+// in nested structs, pointers, slices, arrays, map values and interfaces.
+// Fields promoted from embedded structs are ignored too, even when the embedded type is unexported.
+// Map keys are left unchanged.
+//
+// The assertion fails if a field name doesn't match any exported struct field.
+//
+// To ignore fields in all the calls to [Equal] in a test, use [WithIgnoredFields].
+//
+// # Usage
+//
+//	assertions.EqualIgnoringFields(t, expected, actual, []string{"ID", "CreatedAt"})
+//
+// # Examples
+//
+//	success: dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}
+//	failure: dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}
+func EqualIgnoringFields(t T, expected, actual any, fields []string, msgAndArgs ...any) bool {
+	// Domain: equality
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if err := validateEqualArgs(expected, actual); err != nil {
+		return Fail(t, fmt.Sprintf("Invalid operation: %#v == %#v (%s)",
+			expected, actual, err), msgAndArgs...)
+	}
+
+	ignored := slices.Concat(ignoredFieldsFor(t), fields)
+	eraser := newFieldsEraser(ignored)
+	expected = eraser.copy(expected)
+	actual = eraser.copy(actual)
+
+	for _, field := range fields {
+		if !eraser.isKnown(field, expected, actual) {
+			return Fail(t, fmt.Sprintf("Unknown field %q: no such exported struct field", field), msgAndArgs...)
+		}
+	}
+
+	if !objectsAreEqualFor(t, expected, actual) {
+		return failWithDiffHeader(t, notEqualHeader(ignored), expected, actual, msgAndArgs...)
 	}
 
 	return true
 }
 
+// WithIgnoredFields configures struct fields ignored by [Equal], [NotEqual], [EqualValues] and [NotEqualValues].
+//
+// Like with [EqualIgnoringFields], the ignored fields are zeroed on copies of the compared objects.
+// Unlike [EqualIgnoringFields], a field name that doesn't match any exported struct field is not an error.
+//
+// Fields are ignored in all assertions called with the same [T], until the test completes.
+// They are not inherited by subtests.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithIgnoredFields("ID", "CreatedAt"))
+//	a.Equal(expected, actual)
+func WithIgnoredFields(fields ...string) Option {
+	return func(t T) {
+		if len(fields) == 0 {
+			return
+		}
+
+		configure(t, func(cfg *testConfig) {
+			cfg.ignoredFields = append(cfg.ignoredFields, fields...)
+		})
+	}
+}
+
 // Exactly asserts that two objects are equal in value and type.
 //
 // # Usage
@@ -277,6 +353,18 @@ func failWithDiff(t T, expected, actual any, msgAndArgs ...any) bool {
 		h.Helper()
 	}
 
+	return failWithDiffHeader(t, "Not equal", expected, actual, msgAndArgs...)
+}
+
+// failWithDiffHeader reports unequal values after the given header line.
+//
+// The expected and actual values are colorized whenever colors are enabled,
+// and followed by a unified diff for structs, maps, slices, arrays and strings.
+func failWithDiffHeader(t T, header string, expected, actual any, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
 	diff := diff(expected, actual)
 	expectedStr, actualStr := formatUnequalValues(expected, actual)
 
@@ -285,12 +373,20 @@ func failWithDiff(t T, expected, actual any, msgAndArgs ...any) bool {
 		actualStr = colors.ActualColorizer()(actualStr)
 	}
 
-	return Fail(t,
-		fmt.Sprintf("Not equal: \n"+
+	details := Failure{
+		Expected: expected,
+		Actual:   actual,
+		Diff:     strings.TrimPrefix(diff, "\n\nDiff:\n"),
+	}
+
+	return failWith(t,
+		fmt.Sprintf("%s: \n"+
 			"expected: %s\n"+
 			"actual  : %s%s",
+			header,
 			expectedStr,
 			actualStr, diff),
+		details,
 		msgAndArgs...,
 	)
 }
@@ -417,6 +513,209 @@ func copyExportedFields(expected any) any {
 	}
 }
 
+func ignoredFieldsFor(t T) []string {
+	cfg, ok := configFor(t)
+	if !ok {
+		return nil
+	}
+
+	return cfg.ignoredFields
+}
+
+// eraseIgnoredFields zeroes the struct fields configured with [WithIgnoredFields] on copies of the values.
+func eraseIgnoredFields(t T, expected, actual any) (any, any, []string) {
+	ignored := ignoredFieldsFor(t)
+	if len(ignored) == 0 {
+		return expected, actual, nil
+	}
+
+	eraser := newFieldsEraser(ignored)
+
+	return eraser.copy(expected), eraser.copy(actual), ignored
+}
+
+func notEqualHeader(ignored []string) string {
+	if len(ignored) == 0 {
+		return "Not equal"
+	}
+
+	return fmt.Sprintf("Not equal (ignoring fields: %s)", strings.Join(ignored, ", "))
+}
+
+// fieldsEraser creates copies of values with some named struct fields zeroed.
+type fieldsEraser struct {
+	// ignored field names, and whether a value with this field has been found
+	ignored map[string]bool
+
+	// copied pointers, to support cyclic data structures
+	copied map[pointerKey]reflect.Value
+}
+
+type pointerKey struct {
+	typ  reflect.Type
+	addr uintptr
+}
+
+func newFieldsEraser(fields []string) *fieldsEraser {
+	e := &fieldsEraser{
+		ignored: make(map[string]bool, len(fields)),
+		copied:  make(map[pointerKey]reflect.Value),
+	}
+	for _, field := range fields {
+		e.ignored[field] = false
+	}
+
+	return e
+}
+
+func (e *fieldsEraser) copy(value any) any {
+	if isNil(value) {
+		return value
+	}
+
+	return e.copyValue(reflect.ValueOf(value)).Interface()
+}
+
+func (e *fieldsEraser) copyValue(v reflect.Value) reflect.Value {
+	switch v.Kind() {
+	case reflect.Struct:
+		result := reflect.New(v.Type()).Elem()
+		result.Set(v) // shallow copy, including unexported fields
+		for i := range v.NumField() {
+			field := v.Type().Field(i)
+			if !field.IsExported() {
+				if field.Anonymous {
+					// promoted fields of an embedded unexported type
+					embedded := exportedField(result, i)
+					embedded.Set(e.copyValue(embedded))
+				}
+
+				continue
+			}
+
+			if _, isIgnored := e.ignored[field.Name]; isIgnored {
+				e.ignored[field.Name] = true
+				result.Field(i).SetZero()
+
+				continue
+			}
+
+			result.Field(i).Set(e.copyValue(v.Field(i)))
+		}
+
+		return result
+
+	case reflect.Pointer:
+		if v.IsNil() {
+			return v
+		}
+
+		key := pointerKey{typ: v.Type(), addr: v.Pointer()}
+		if copied, ok := e.copied[key]; ok {
+			return copied
+		}
+
+		result := reflect.New(v.Type().Elem())
+		e.copied[key] = result
+		result.Elem().Set(e.copyValue(v.Elem()))
+
+		return result
+
+	case reflect.Interface:
+		if v.IsNil() {
+			return v
+		}
+
+		result := reflect.New(v.Type()).Elem()
+		result.Set(e.copyValue(v.Elem()))
+
+		return result
+
+	case reflect.Slice:
+		if v.IsNil() {
+			return v
+		}
+
+		result := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		for i := range v.Len() {
+			result.Index(i).Set(e.copyValue(v.Index(i)))
+		}
+
+		return result
+
+	case reflect.Array:
+		result := reflect.New(v.Type()).Elem()
+		for i := range v.Len() {
+			result.Index(i).Set(e.copyValue(v.Index(i)))
+		}
+
+		return result
+
+	case reflect.Map:
+		if v.IsNil() {
+			return v
+		}
+
+		result := reflect.MakeMapWithSize(v.Type(), v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			result.SetMapIndex(iter.Key(), e.copyValue(iter.Value()))
+		}
+
+		return result
+
+	default:
+		return v
+	}
+}
+
+// isKnown tells if a field name has been found in the values, or in their types
+// (e.g. for elements of an empty slice).
+func (e *fieldsEraser) isKnown(field string, values ...any) bool {
+	if e.ignored[field] {
+		return true
+	}
+
+	visited := make(map[reflect.Type]bool)
+	for _, value := range values {
+		if typeHasField(reflect.TypeOf(value), field, visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func typeHasField(typ reflect.Type, field string, visited map[reflect.Type]bool) bool {
+	if typ == nil || visited[typ] {
+		return false
+	}
+	visited[typ] = true
+
+	switch typ.Kind() {
+	case reflect.Struct:
+		for i := range typ.NumField() {
+			f := typ.Field(i)
+			if !f.IsExported() {
+				if f.Anonymous && typeHasField(f.Type, field, visited) {
+					return true
+				}
+
+				continue
+			}
+			if f.Name == field || typeHasField(f.Type, field, visited) {
+				return true
+			}
+		}
+
+		return false
+	case reflect.Pointer, reflect.Slice, reflect.Array, reflect.Map:
+		return typeHasField(typ.Elem(), field, visited)
+	default:
+		return false
+	}
+}
+
 func isFunction(arg any) bool {
 	if arg == nil {
 		return false
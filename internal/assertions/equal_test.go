@@ -8,6 +8,7 @@ import (
 	"iter"
 	"slices"
 	"testing"
+	"time"
 )
 
 // Test EqualValues and NotEqualValues.
@@ -72,6 +73,58 @@ func TestEqualExportedValuesErrorMessages(t *testing.T) {
 	runFailCases(t, equalExportedValuesFailCases())
 }
 
+// Test EqualIgnoringFields.
+func TestEqualIgnoringFields(t *testing.T) {
+	t.Parallel()
+
+	for tc := range equalIgnoringFieldsCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			res := EqualIgnoringFields(mock, tc.expected, tc.actual, tc.fields)
+			shouldPassOrFail(t, mock, res, tc.expectedEqual)
+		})
+	}
+}
+
+func TestEqualWithIgnoredFields(t *testing.T) {
+	t.Parallel()
+
+	for tc := range equalIgnoringFieldsCases() {
+		// unlike EqualIgnoringFields, unknown fields are not an error with WithIgnoredFields
+		equal := tc.expectedEqual || tc.unknownFields
+
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			t.Run("with Equal", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithIgnoredFields(tc.fields...)(mock)
+				res := Equal(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, equal)
+			})
+
+			t.Run("with NotEqual", func(t *testing.T) {
+				t.Parallel()
+
+				mock := new(mockT)
+				WithIgnoredFields(tc.fields...)(mock)
+				res := NotEqual(mock, tc.expected, tc.actual)
+				shouldPassOrFail(t, mock, res, !equal && !isFunction(tc.expected))
+			})
+		})
+	}
+}
+
+func TestEqualIgnoringFieldsErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	runFailCases(t, equalIgnoringFieldsFailCases())
+}
+
 // Deep equality tests (Equal, EqualT, NotEqual, NotEqualT, Exactly).
 func TestEqualDeepEqual(t *testing.T) {
 	t.Parallel()
@@ -839,3 +892,261 @@ func equalExportedValuesFailCases() iter.Seq[failCase] {
 		},
 	})
 }
+
+// ============================================================================
+// TestEqualIgnoringFields
+// ============================================================================
+
+type ignoredFieldsRecord struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+	Tags      []string
+	Owner     *ignoredFieldsRecord
+	Children  []ignoredFieldsRecord
+	Labels    map[string]ignoredFieldsRecord
+	Extra     any
+	private   int
+}
+
+type ignoredFieldsNode struct {
+	ID   int
+	Next *ignoredFieldsNode
+}
+
+type ignoredFieldsBase struct {
+	ID        int
+	CreatedAt time.Time
+}
+
+type ignoredFieldsModel struct {
+	ignoredFieldsBase
+
+	Title string
+}
+
+type ignoredFieldsPointerModel struct {
+	*ignoredFieldsBase
+
+	Title string
+}
+
+type ignoredFieldsKey struct {
+	ID int
+}
+
+type equalIgnoringFieldsCase struct {
+	name          string
+	expected      any
+	actual        any
+	fields        []string
+	expectedEqual bool
+	unknownFields bool
+}
+
+func equalIgnoringFieldsCases() iter.Seq[equalIgnoringFieldsCase] {
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	cyclic := func(id int) *ignoredFieldsNode {
+		node := &ignoredFieldsNode{ID: id}
+		node.Next = node
+
+		return node
+	}
+
+	return slices.Values([]equalIgnoringFieldsCase{
+		{
+			name:          "top-level/ignored-fields-differ",
+			expected:      ignoredFieldsRecord{ID: 1, Name: "a", CreatedAt: now},
+			actual:        ignoredFieldsRecord{ID: 2, Name: "a", CreatedAt: later},
+			fields:        []string{"ID", "CreatedAt"},
+			expectedEqual: true,
+		},
+		{
+			name:          "top-level/other-field-differs",
+			expected:      ignoredFieldsRecord{ID: 1, Name: "a"},
+			actual:        ignoredFieldsRecord{ID: 2, Name: "b"},
+			fields:        []string{"ID"},
+			expectedEqual: false,
+		},
+		{
+			name:          "top-level/unexported-field-still-compared",
+			expected:      ignoredFieldsRecord{ID: 1, private: 1},
+			actual:        ignoredFieldsRecord{ID: 2, private: 2},
+			fields:        []string{"ID"},
+			expectedEqual: false,
+		},
+		{
+			name:          "pointers/ignored-fields-differ",
+			expected:      &ignoredFieldsRecord{ID: 1, Owner: &ignoredFieldsRecord{ID: 3, Name: "o"}},
+			actual:        &ignoredFieldsRecord{ID: 2, Owner: &ignoredFieldsRecord{ID: 4, Name: "o"}},
+			fields:        []string{"ID"},
+			expectedEqual: true,
+		},
+		{
+			name:          "pointers/nested-field-differs",
+			expected:      &ignoredFieldsRecord{Owner: &ignoredFieldsRecord{Name: "o"}},
+			actual:        &ignoredFieldsRecord{Owner: &ignoredFieldsRecord{Name: "p"}},
+			fields:        []string{"ID"},
+			expectedEqual: false,
+		},
+		{
+			name: "collections/ignored-fields-differ",
+			expected: ignoredFieldsRecord{
+				Children: []ignoredFieldsRecord{{ID: 1, CreatedAt: now}},
+				Labels:   map[string]ignoredFieldsRecord{"x": {ID: 1, Tags: []string{"t"}}},
+				Extra:    ignoredFieldsRecord{ID: 1},
+			},
+			actual: ignoredFieldsRecord{
+				Children: []ignoredFieldsRecord{{ID: 2, CreatedAt: later}},
+				Labels:   map[string]ignoredFieldsRecord{"x": {ID: 2, Tags: []string{"t"}}},
+				Extra:    ignoredFieldsRecord{ID: 2},
+			},
+			fields:        []string{"ID", "CreatedAt"},
+			expectedEqual: true,
+		},
+		{
+			name:          "collections/slice-of-structs",
+			expected:      []ignoredFieldsRecord{{ID: 1, Name: "a"}, {ID: 2, Name: "b"}},
+			actual:        []ignoredFieldsRecord{{ID: 3, Name: "a"}, {ID: 4, Name: "b"}},
+			fields:        []string{"ID"},
+			expectedEqual: true,
+		},
+		{
+			name:          "collections/empty-slice-with-known-field",
+			expected:      []ignoredFieldsRecord{},
+			actual:        []ignoredFieldsRecord{},
+			fields:        []string{"CreatedAt"},
+			expectedEqual: true,
+		},
+		{
+			name:          "cyclic/ignored-fields-differ",
+			expected:      cyclic(1),
+			actual:        cyclic(2),
+			fields:        []string{"ID"},
+			expectedEqual: true,
+		},
+		{
+			name: "embedded/promoted-fields-differ",
+			expected: ignoredFieldsModel{
+				ignoredFieldsBase: ignoredFieldsBase{ID: 1, CreatedAt: now}, Title: "a",
+			},
+			actual: ignoredFieldsModel{
+				ignoredFieldsBase: ignoredFieldsBase{ID: 2, CreatedAt: later}, Title: "a",
+			},
+			fields:        []string{"ID", "CreatedAt"},
+			expectedEqual: true,
+		},
+		{
+			name:          "embedded/other-field-differs",
+			expected:      ignoredFieldsModel{ignoredFieldsBase: ignoredFieldsBase{ID: 1}, Title: "a"},
+			actual:        ignoredFieldsModel{ignoredFieldsBase: ignoredFieldsBase{ID: 2}, Title: "b"},
+			fields:        []string{"ID"},
+			expectedEqual: false,
+		},
+		{
+			name:          "embedded/pointer",
+			expected:      ignoredFieldsPointerModel{ignoredFieldsBase: &ignoredFieldsBase{ID: 1}, Title: "a"},
+			actual:        ignoredFieldsPointerModel{ignoredFieldsBase: &ignoredFieldsBase{ID: 2}, Title: "a"},
+			fields:        []string{"ID"},
+			expectedEqual: true,
+		},
+		{
+			name:          "embedded/unset-pointer",
+			expected:      ignoredFieldsPointerModel{Title: "a"},
+			actual:        ignoredFieldsPointerModel{Title: "a"},
+			fields:        []string{"ID"},
+			expectedEqual: true,
+		},
+		{
+			name:          "map-keys/not-erased",
+			expected:      map[ignoredFieldsKey]int{{ID: 1}: 1},
+			actual:        map[ignoredFieldsKey]int{{ID: 1}: 1},
+			fields:        []string{"ID"},
+			expectedEqual: false,
+			unknownFields: true,
+		},
+		{
+			name:          "no-fields/same-as-equal",
+			expected:      ignoredFieldsRecord{ID: 1},
+			actual:        ignoredFieldsRecord{ID: 2},
+			fields:        nil,
+			expectedEqual: false,
+		},
+		{
+			name:          "unknown-field",
+			expected:      ignoredFieldsRecord{ID: 1},
+			actual:        ignoredFieldsRecord{ID: 1},
+			fields:        []string{"UpdatedAt"},
+			expectedEqual: false,
+			unknownFields: true,
+		},
+		{
+			name:          "unexported-field",
+			expected:      ignoredFieldsRecord{private: 1},
+			actual:        ignoredFieldsRecord{private: 1},
+			fields:        []string{"private"},
+			expectedEqual: false,
+			unknownFields: true,
+		},
+		{
+			name:          "edge-case/func",
+			expected:      func() {},
+			actual:        func() {},
+			fields:        nil,
+			expectedEqual: false,
+		},
+	})
+}
+
+func equalIgnoringFieldsFailCases() iter.Seq[failCase] {
+	return slices.Values([]failCase{
+		{
+			name: "diff-in-other-field",
+			assertion: func(t T) bool {
+				return EqualIgnoringFields(t,
+					ignoredFieldsRecord{ID: 1, Name: "a"},
+					ignoredFieldsRecord{ID: 2, Name: "b"},
+					[]string{"ID", "CreatedAt"},
+				)
+			},
+			wantContains: []string{
+				"Not equal (ignoring fields: ID, CreatedAt):",
+				"--- Expected",
+				"+++ Actual",
+				`- Name: (string) (len=1) "a",`,
+				`+ Name: (string) (len=1) "b",`,
+			},
+		},
+		{
+			name: "with-ignored-fields",
+			assertion: func(t T) bool {
+				WithIgnoredFields("ID", "CreatedAt")(t)
+
+				return Equal(t,
+					ignoredFieldsRecord{ID: 1, Name: "a"},
+					ignoredFieldsRecord{ID: 2, Name: "b"},
+				)
+			},
+			wantContains: []string{
+				"Not equal (ignoring fields: ID, CreatedAt):",
+				`- Name: (string) (len=1) "a",`,
+				`+ Name: (string) (len=1) "b",`,
+			},
+		},
+		{
+			name: "unknown-field",
+			assertion: func(t T) bool {
+				return EqualIgnoringFields(t,
+					ignoredFieldsRecord{ID: 1},
+					ignoredFieldsRecord{ID: 1},
+					[]string{"UpdatedAt"},
+				)
+			},
+			wantContains: []string{
+				`Unknown field "UpdatedAt": no such exported struct field`,
+			},
+		},
+	})
+}
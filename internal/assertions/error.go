@@ -229,18 +229,37 @@ func ErrorAs(t T, err error, target any, msgAndArgs ...any) bool {
 		return true
 	}
 
-	expectedType := reflect.TypeOf(target).Elem().String()
-	if err == nil {
-		return Fail(t, fmt.Sprintf("An error is expected but got nil.\n"+
-			"expected: %s", expectedType), msgAndArgs...)
+	return failErrorAs(t, err, reflect.TypeOf(target).Elem().String(), msgAndArgs...)
+}
+
+// ErrorAsType asserts that at least one of the errors in err's chain matches the type E,
+// and if so, returns that error value.
+//
+// This is a wrapper for [errors.As] that saves the declaration of a target variable.
+// The type parameter E cannot be inferred and must be specified explicitly.
+//
+// It returns the zero value of E and false if no error in the chain matches.
+//
+// # Usage
+//
+//	target, ok := assertions.ErrorAsType[*MyError](t, err)
+//
+// # Examples
+//
+//	success: fmt.Errorf("wrap: %w", &dummyError{})
+//	failure: ErrTest
+func ErrorAsType[E error](t T, err error, msgAndArgs ...any) (E, bool) {
+	// Domain: error
+	if h, ok := t.(H); ok {
+		h.Helper()
 	}
 
-	chain := buildErrorChainString(err, true)
+	var target E
+	if errors.As(err, &target) {
+		return target, true
+	}
 
-	return Fail(t, fmt.Sprintf("Should be in error chain:\n"+
-		"expected: %s\n"+
-		"in chain: %s", expectedType, truncatingFormat("%s", chain),
-	), msgAndArgs...)
+	return target, failErrorAs(t, err, reflect.TypeFor[E]().String(), msgAndArgs...)
 }
 
 // NotErrorAs asserts that none of the errors in err's chain matches target,
@@ -271,6 +290,24 @@ func NotErrorAs(t T, err error, target any, msgAndArgs ...any) bool {
 	), msgAndArgs...)
 }
 
+func failErrorAs(t T, err error, expectedType string, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if err == nil {
+		return Fail(t, fmt.Sprintf("An error is expected but got nil.\n"+
+			"expected: %s", expectedType), msgAndArgs...)
+	}
+
+	chain := buildErrorChainString(err, true)
+
+	return Fail(t, fmt.Sprintf("Should be in error chain:\n"+
+		"expected: %s\n"+
+		"in chain: %s", expectedType, truncatingFormat("%s", chain),
+	), msgAndArgs...)
+}
+
 func unwrapAll(err error) (errs []error) {
 	errs = append(errs, err)
 	switch x := err.(type) { //nolint:errorlint // false positive: this type switch is checking for interfaces
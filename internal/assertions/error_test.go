@@ -185,6 +185,27 @@ func TestErrorAs(t *testing.T) {
 	}
 }
 
+func TestErrorAsType(t *testing.T) {
+	t.Parallel()
+
+	for tt := range errorAsCases() {
+		t.Run(fmt.Sprintf("ErrorAsType[*customError](%#v)", tt.err), func(t *testing.T) {
+			t.Parallel()
+			mock := new(mockT)
+
+			target, res := ErrorAsType[*customError](mock, tt.err)
+			shouldPassOrFail(t, mock, res, tt.result)
+
+			if res && target == nil {
+				t.Error("expected ErrorAsType to return the matching error")
+			}
+			if !res && target != nil {
+				t.Errorf("expected ErrorAsType to return a nil error on failure, got %v", target)
+			}
+		})
+	}
+}
+
 // ============================================================================
 // TestNotErrorAs
 // ============================================================================
@@ -414,6 +435,29 @@ func errorFailCases() iter.Seq[failCase] {
 				"\t\"def\" (*errors.errorString)",
 		},
 
+		// --- ErrorAsType message cases ---
+		{
+			name: "ErrorAsType/not_in_chain",
+			assertion: func(t T) bool {
+				_, ok := ErrorAsType[*customError](t, io.EOF)
+				return ok
+			},
+			wantError: "" +
+				"Should be in error chain:\n" +
+				fmt.Sprintf("expected: *%s.customError\n", shortpkg) +
+				"in chain: \"EOF\" (*errors.errorString)",
+		},
+		{
+			name: "ErrorAsType/nil_err",
+			assertion: func(t T) bool {
+				_, ok := ErrorAsType[*customError](t, nil)
+				return ok
+			},
+			wantError: "" +
+				"An error is expected but got nil.\n" +
+				fmt.Sprintf("expected: *%s.customError", shortpkg),
+		},
+
 		// --- NotErrorAs message cases ---
 		{
 			name: "NotErrorAs/found_in_chain",
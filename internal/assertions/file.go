@@ -4,9 +4,13 @@
 package assertions
 
 import (
+	"bytes"
 	"fmt"
 	"io/fs"
 	"os"
+	"slices"
+	"strings"
+	"unicode/utf8"
 )
 
 // FileExists checks whether a file exists in the given path. It also fails if
@@ -210,6 +214,248 @@ func FileNotEmpty(t T, path string, msgAndArgs ...any) bool {
 	return true
 }
 
+// FileEqual asserts that two files have the same content.
+//
+// This is typically used to compare a generated file against a golden file.
+// It fails if any of the paths cannot be read, or points to a directory.
+//
+// On failure, the content is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FileEqual(t, "testdata/golden.txt", "path/to/generated.txt")
+//
+// # Examples
+//
+//	success: filepath.Join(testDataPath(),"existing_file"), filepath.Join(testDataPath(),"existing_file")
+//	failure: filepath.Join(testDataPath(),"existing_file"), filepath.Join(testDataPath(),"empty_file")
+func FileEqual(t T, expected, actual string, msgAndArgs ...any) bool {
+	// Domain: file
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	expectedContent, err := os.ReadFile(expected)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("unable to read expected file %q: %v", expected, err), msgAndArgs...)
+	}
+
+	return FileEqualBytes(t, expectedContent, actual, msgAndArgs...)
+}
+
+// FileEqualBytes asserts that the content of a file is equal to the expected bytes.
+//
+// It fails if the path cannot be read, or points to a directory.
+//
+// On failure, the content is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FileEqualBytes(t, []byte("hello\n"), "path/to/file")
+//
+// # Examples
+//
+//	success: []byte("NOT EMPTY\n"), filepath.Join(testDataPath(),"existing_file")
+//	failure: []byte("EMPTY\n"), filepath.Join(testDataPath(),"existing_file")
+func FileEqualBytes(t T, expected []byte, actual string, msgAndArgs ...any) bool {
+	// Domain: file
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	actualContent, err := os.ReadFile(actual)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("unable to read file %q: %v", actual, err), msgAndArgs...)
+	}
+
+	if bytes.Equal(expected, actualContent) {
+		return true
+	}
+
+	if !isText(expected) || !isText(actualContent) {
+		return Fail(t, fmt.Sprintf("File %q does not have the expected content (expected %d bytes, actual %d bytes)",
+			actual, len(expected), len(actualContent)), msgAndArgs...)
+	}
+
+	return failWithDiffHeader(t, fmt.Sprintf("File %q does not have the expected content", actual), string(expected), string(actualContent), msgAndArgs...)
+}
+
+// DirEqual asserts that two directory trees contain the same files, with the same content.
+//
+// Only regular files are compared: empty directories and other entries, e.g. symbolic links, are ignored.
+//
+// On failure, a summary lists the files missing from actual, the unexpected files in actual
+// and the files with a different content.
+// The content of differing files is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.DirEqual(t, "testdata/golden", "path/to/generated")
+//
+// # Examples
+//
+//	success: filepath.Join(testDataPath(),"existing_dir"), filepath.Join(testDataPath(),"existing_dir")
+//	failure: testDataPath(), filepath.Join(testDataPath(),"existing_dir")
+func DirEqual(t T, expected, actual string, msgAndArgs ...any) bool {
+	// Domain: file
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	for _, dir := range []string{expected, actual} {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return Fail(t, fmt.Sprintf("unable to find directory %q: %v", dir, err), msgAndArgs...)
+		}
+		if !info.IsDir() {
+			return Fail(t, fmt.Sprintf("%q is not a directory", dir), msgAndArgs...)
+		}
+	}
+
+	summary, err := diffFS(os.DirFS(expected), os.DirFS(actual))
+	if err != nil {
+		return Fail(t, err.Error(), msgAndArgs...)
+	}
+
+	if summary != "" {
+		return Fail(t, fmt.Sprintf("directories %q and %q differ:%s", expected, actual, summary), msgAndArgs...)
+	}
+
+	return true
+}
+
+// FSEqual asserts that two file systems contain the same files, with the same content.
+//
+// This is useful to compare an [fs.FS] produced by a test (e.g. an in-memory file system)
+// with a golden directory, using [os.DirFS].
+//
+// Only regular files are compared: empty directories and other entries, e.g. symbolic links, are ignored.
+//
+// The content of differing files is reported as a unified diff, unless it is binary.
+//
+// # Usage
+//
+//	assertions.FSEqual(t, os.DirFS("testdata/golden"), generated)
+//
+// # Examples
+//
+//	success: os.DirFS(testDataPath()), os.DirFS(testDataPath())
+//	failure: os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(),"existing_dir"))
+func FSEqual(t T, expected, actual fs.FS, msgAndArgs ...any) bool {
+	// Domain: file
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	summary, err := diffFS(expected, actual)
+	if err != nil {
+		return Fail(t, err.Error(), msgAndArgs...)
+	}
+
+	if summary != "" {
+		return Fail(t, "file systems differ:"+summary, msgAndArgs...)
+	}
+
+	return true
+}
+
+// diffFS compares the files of two file systems.
+//
+// It returns an empty summary when both file systems have the same files, with the same content.
+func diffFS(expected, actual fs.FS) (string, error) {
+	expectedFiles, err := listFiles(expected)
+	if err != nil {
+		return "", fmt.Errorf("unable to list expected files: %w", err)
+	}
+
+	actualFiles, err := listFiles(actual)
+	if err != nil {
+		return "", fmt.Errorf("unable to list actual files: %w", err)
+	}
+
+	var missing, unexpected, different, diffs []string
+	for _, name := range expectedFiles {
+		if _, found := slices.BinarySearch(actualFiles, name); !found {
+			missing = append(missing, name)
+
+			continue
+		}
+
+		expectedContent, err := fs.ReadFile(expected, name)
+		if err != nil {
+			return "", fmt.Errorf("unable to read expected file %q: %w", name, err)
+		}
+		actualContent, err := fs.ReadFile(actual, name)
+		if err != nil {
+			return "", fmt.Errorf("unable to read actual file %q: %w", name, err)
+		}
+
+		if bytes.Equal(expectedContent, actualContent) {
+			continue
+		}
+
+		different = append(different, fmt.Sprintf("%s (expected %d bytes, actual %d bytes)", name, len(expectedContent), len(actualContent)))
+		if isText(expectedContent) && isText(actualContent) {
+			diffs = append(diffs, fmt.Sprintf("\n\nDiff of %s:\n%s",
+				name, strings.TrimPrefix(diff(string(expectedContent), string(actualContent)), "\n\nDiff:\n"),
+			))
+		}
+	}
+
+	for _, name := range actualFiles {
+		if _, found := slices.BinarySearch(expectedFiles, name); !found {
+			unexpected = append(unexpected, name)
+		}
+	}
+
+	var summary strings.Builder
+	writeFileList(&summary, "missing files", missing)
+	writeFileList(&summary, "unexpected files", unexpected)
+	writeFileList(&summary, "files with a different content", different)
+	for _, fileDiff := range diffs {
+		summary.WriteString(strings.TrimRight(fileDiff, "\n"))
+	}
+
+	return summary.String(), nil
+}
+
+// isText tells if a file content is valid UTF-8 text, which may be reported as a diff.
+func isText(content []byte) bool {
+	return utf8.Valid(content) && bytes.IndexByte(content, 0) < 0
+}
+
+// listFiles returns the sorted names of all regular files in a file system.
+func listFiles(fsys fs.FS) ([]string, error) {
+	var files []string
+	err := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.Type().IsRegular() {
+			files = append(files, name)
+		}
+
+		return nil
+	})
+
+	slices.Sort(files)
+
+	return files, err
+}
+
+func writeFileList(w *strings.Builder, title string, names []string) {
+	if len(names) == 0 {
+		return
+	}
+
+	fmt.Fprintf(w, "\n%s:", title)
+	for _, name := range names {
+		w.WriteString("\n  - ")
+		w.WriteString(name)
+	}
+}
+
 func lstat(path, kind string) (info os.FileInfo, err error) {
 	info, err = os.Lstat(path)
 	if err != nil {
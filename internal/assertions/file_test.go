@@ -10,6 +10,7 @@ import (
 	"runtime"
 	"slices"
 	"testing"
+	"testing/fstest"
 )
 
 func TestFileExists(t *testing.T) {
@@ -134,6 +135,62 @@ func TestFileLstatPermissionError(t *testing.T) {
 	}
 }
 
+func TestFileEqual(t *testing.T) {
+	t.Parallel()
+
+	for c := range fileEqualCases() {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			res := c.assertion(mock)
+			shouldPassOrFail(t, mock, res, c.result)
+		})
+	}
+}
+
+func TestDirEqual(t *testing.T) {
+	t.Parallel()
+
+	expected := makeTestTree(t, map[string]string{
+		"a.txt":       "a",
+		"sub/b.txt":   "b",
+		"sub/c/d.txt": "d",
+	})
+
+	for c := range dirEqualCases() {
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			actual := makeTestTree(t, c.files)
+
+			mock := new(mockT)
+			res := DirEqual(mock, expected, actual)
+			shouldPassOrFail(t, mock, res, c.result)
+
+			res = FSEqual(mock, os.DirFS(expected), mapFS(c.files))
+			shouldPassOrFail(t, mock, res, c.result)
+		})
+	}
+
+	t.Run("should ignore symbolic links", func(t *testing.T) {
+		t.Parallel()
+
+		actual := makeTestTree(t, map[string]string{
+			"a.txt":       "a",
+			"sub/b.txt":   "b",
+			"sub/c/d.txt": "d",
+		})
+		if err := os.Symlink("a.txt", filepath.Join(actual, "link.txt")); err != nil {
+			t.Skipf("symbolic links are not supported: %v", err)
+		}
+
+		mock := new(mockT)
+		res := DirEqual(mock, expected, actual)
+		shouldPassOrFail(t, mock, res, true)
+	})
+}
+
 func TestFileErrorMessages(t *testing.T) {
 	t.Parallel()
 
@@ -240,6 +297,88 @@ func fileNotEmptyCases() iter.Seq[fileTestCase] {
 	})
 }
 
+type fileAssertionCase struct {
+	name      string
+	assertion func(T) bool
+	result    bool
+}
+
+func fileEqualCases() iter.Seq[fileAssertionCase] {
+	existing := filepath.Join("testdata", "existing_file")
+	empty := filepath.Join("testdata", "empty_file")
+	dir := filepath.Join("testdata", "existing_dir")
+
+	return slices.Values([]fileAssertionCase{
+		{name: "FileEqual/same-file", assertion: func(t T) bool { return FileEqual(t, existing, existing) }, result: true},
+		{name: "FileEqual/different-files", assertion: func(t T) bool { return FileEqual(t, existing, empty) }, result: false},
+		{name: "FileEqual/expected-not-found", assertion: func(t T) bool { return FileEqual(t, "non_existent_file", existing) }, result: false},
+		{name: "FileEqual/actual-not-found", assertion: func(t T) bool { return FileEqual(t, existing, "non_existent_file") }, result: false},
+		{name: "FileEqual/directory", assertion: func(t T) bool { return FileEqual(t, existing, dir) }, result: false},
+		{name: "FileEqualBytes/same-content", assertion: func(t T) bool { return FileEqualBytes(t, []byte("NOT EMPTY\n"), existing) }, result: true},
+		{name: "FileEqualBytes/empty", assertion: func(t T) bool { return FileEqualBytes(t, nil, empty) }, result: true},
+		{name: "FileEqualBytes/different-content", assertion: func(t T) bool { return FileEqualBytes(t, []byte("NOT EMPTY"), existing) }, result: false},
+		{name: "FileEqualBytes/not-found", assertion: func(t T) bool { return FileEqualBytes(t, nil, "non_existent_file") }, result: false},
+	})
+}
+
+type dirEqualCase struct {
+	name   string
+	files  map[string]string
+	result bool
+}
+
+func dirEqualCases() iter.Seq[dirEqualCase] {
+	return slices.Values([]dirEqualCase{
+		{
+			name:   "same-tree",
+			files:  map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/c/d.txt": "d"},
+			result: true,
+		},
+		{
+			name:   "missing-file",
+			files:  map[string]string{"a.txt": "a", "sub/b.txt": "b"},
+			result: false,
+		},
+		{
+			name:   "unexpected-file",
+			files:  map[string]string{"a.txt": "a", "sub/b.txt": "b", "sub/c/d.txt": "d", "e.txt": "e"},
+			result: false,
+		},
+		{
+			name:   "different-content",
+			files:  map[string]string{"a.txt": "a", "sub/b.txt": "B", "sub/c/d.txt": "d"},
+			result: false,
+		},
+	})
+}
+
+// makeTestTree creates a temporary directory with the given files.
+func makeTestTree(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for name, content := range files {
+		path := filepath.Join(root, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return root
+}
+
+func mapFS(files map[string]string) fstest.MapFS {
+	fsys := make(fstest.MapFS, len(files))
+	for name, content := range files {
+		fsys[name] = &fstest.MapFile{Data: []byte(content)}
+	}
+
+	return fsys
+}
+
 // ============================================================================
 // TestFileErrorMessages
 // ============================================================================
@@ -296,5 +435,65 @@ func fileFailCases() iter.Seq[failCase] {
 			assertion:    func(t T) bool { return FileNotEmpty(t, "nonexistent_file") },
 			wantContains: []string{"unable to find file"},
 		},
+		{
+			name:         "FileEqual/expected-not-found",
+			assertion:    func(t T) bool { return FileEqual(t, "nonexistent_file", filepath.Join("testdata", "existing_file")) },
+			wantContains: []string{`unable to read expected file "nonexistent_file"`},
+		},
+		{
+			name: "FileEqualBytes/different-content",
+			assertion: func(t T) bool {
+				return FileEqualBytes(t, []byte("EMPTY\n"), filepath.Join("testdata", "existing_file"))
+			},
+			wantContains: []string{
+				"does not have the expected content",
+				"-EMPTY",
+				"+NOT EMPTY",
+			},
+		},
+		{
+			name: "FileEqualBytes/binary-content",
+			assertion: func(t T) bool {
+				return FileEqualBytes(t, []byte("\x00\x01"), filepath.Join("testdata", "existing_file"))
+			},
+			wantError: `File "testdata/existing_file" does not have the expected content (expected 2 bytes, actual 10 bytes)`,
+		},
+		{
+			name:         "DirEqual/not-a-directory",
+			assertion:    func(t T) bool { return DirEqual(t, "testdata", filepath.Join("testdata", "existing_file")) },
+			wantContains: []string{"is not a directory"},
+		},
+		{
+			name:         "DirEqual/nonexistent",
+			assertion:    func(t T) bool { return DirEqual(t, "testdata", "nonexistent_dir") },
+			wantContains: []string{`unable to find directory "nonexistent_dir"`},
+		},
+		{
+			name: "FSEqual/summary",
+			assertion: func(t T) bool {
+				return FSEqual(t,
+					mapFS(map[string]string{"a.txt": "a", "b.txt": "b", "c/d.txt": "d"}),
+					mapFS(map[string]string{"a.txt": "A!", "c/d.txt": "d", "e.txt": "e"}),
+				)
+			},
+			wantContains: []string{
+				"file systems differ:",
+				"missing files:\n  - b.txt",
+				"unexpected files:\n  - e.txt",
+				"files with a different content:\n  - a.txt (expected 1 bytes, actual 2 bytes)",
+				"Diff of a.txt:\n--- Expected\n+++ Actual\n@@ -1 +1 @@\n-a\n+A!",
+			},
+		},
+		{
+			name: "FSEqual/binary-content",
+			assertion: func(t T) bool {
+				return FSEqual(t,
+					mapFS(map[string]string{"a.bin": "\x00\x01", "b.txt": "line1\nline2\n"}),
+					mapFS(map[string]string{"a.bin": "\x00\x02", "b.txt": "line1\nline3\n"}),
+				)
+			},
+			wantMatch: `(?s)files with a different content:\n  - a\.bin \(expected 2 bytes, actual 2 bytes\)\n  - b\.txt .*` +
+				`\n\nDiff of b\.txt:\n--- Expected\n\+\+\+ Actual\n@@ -1,3 \+1,3 @@\n line1\n-line2\n\+line3\n $`,
+		},
 	})
 }
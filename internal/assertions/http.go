@@ -6,6 +6,7 @@ package assertions
 import (
 	"context"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -188,6 +189,155 @@ func HTTPBodyNotContains(t T, handler http.HandlerFunc, method, url string, valu
 	return !contains
 }
 
+// HTTPBodyJSONEq asserts that a specified handler returns a body that is semantically
+// equivalent to the expected JSON document.
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// See also [JSONEq].
+//
+// # Usage
+//
+//	assertions.HTTPBodyJSONEq(t, myHandler, "GET", "/api/v1/users/1", nil, `{"id": 1, "name": "Alice"}`)
+//
+// # Examples
+//
+//	success: httpJSON, "GET", "/", nil, `{"hello": "world"}`
+//	failure: httpJSON, "GET", "/", nil, `{"hello": "bob"}`
+func HTTPBodyJSONEq(t T, handler http.HandlerFunc, method, url string, values url.Values, expected string, msgAndArgs ...any) bool {
+	// Domain: http
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	w, err := httpRecord(handler, method, url, values)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("failed to build test request, got error: %v", err), msgAndArgs...)
+	}
+
+	return JSONEqBytes(t, []byte(expected), w.Body.Bytes(), msgAndArgs...)
+}
+
+// HTTPHeader asserts that a specified handler returns a response header with the expected value.
+//
+// Only the first value of the header is considered (see [http.Header.Get]).
+// A header set with no value, e.g. to suppress an automatic header, is considered empty.
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// # Usage
+//
+//	assertions.HTTPHeader(t, myHandler, "GET", "/api/v1/users/1", nil, "Content-Type", "application/json")
+//
+// # Examples
+//
+//	success: httpJSON, "GET", "/", nil, "Content-Type", "application/json"
+//	failure: httpJSON, "GET", "/", nil, "Content-Type", "text/plain"
+func HTTPHeader(t T, handler http.HandlerFunc, method, url string, values url.Values, header, expected string, msgAndArgs ...any) bool {
+	// Domain: http
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	w, err := httpRecord(handler, method, url, values)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("failed to build test request, got error: %v", err), msgAndArgs...)
+	}
+
+	actual, found := w.Header()[http.CanonicalHeaderKey(header)]
+	if !found {
+		return Fail(t, fmt.Sprintf("Expected response header %q for %q to be %q but it is not set", header, url+"?"+values.Encode(), expected), msgAndArgs...)
+	}
+	if len(actual) == 0 {
+		// the header is set with no value, e.g. to suppress an automatic header
+		if expected == "" {
+			return true
+		}
+
+		return Fail(t, fmt.Sprintf("Expected response header %q for %q to be %q but it is set with no value", header, url+"?"+values.Encode(), expected), msgAndArgs...)
+	}
+	if actual[0] != expected {
+		return Fail(t, fmt.Sprintf("Expected response header %q for %q to be %q but found %q", header, url+"?"+values.Encode(), expected, actual[0]), msgAndArgs...)
+	}
+
+	return true
+}
+
+// HTTPRoundTripStatusCode asserts that sending a request through a [http.RoundTripper]
+// returns a specified status code.
+//
+// Unlike [HTTPStatusCode], this exercises a client transport, e.g. a chain of client middlewares.
+// A nil round tripper stands for [http.DefaultTransport].
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// # Usage
+//
+//	req, _ := http.NewRequestWithContext(t.Context(), "GET", server.URL+"/health", http.NoBody)
+//	assertions.HTTPRoundTripStatusCode(t, myTransport, req, http.StatusOK)
+//
+// # Examples
+//
+//	success: httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK
+//	failure: httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK
+func HTTPRoundTripStatusCode(t T, rt http.RoundTripper, req *http.Request, statuscode int, msgAndArgs ...any) bool {
+	// Domain: http
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if req == nil || req.URL == nil {
+		return Fail(t, "a non-nil request with a URL is required", msgAndArgs...)
+	}
+
+	code, _, err := httpRoundTrip(rt, req)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("HTTP round trip failed for %q: %v", req.URL, err), msgAndArgs...)
+	}
+
+	if code != statuscode {
+		return Fail(t, fmt.Sprintf("expected HTTP status code %d for %q but received %d", statuscode, req.URL, code), msgAndArgs...)
+	}
+
+	return true
+}
+
+// HTTPRoundTripBodyJSONEq asserts that sending a request through a [http.RoundTripper]
+// returns a body that is semantically equivalent to the expected JSON document.
+//
+// A nil round tripper stands for [http.DefaultTransport].
+//
+// Returns whether the assertion was successful (true) or not (false).
+//
+// See also [JSONEq].
+//
+// # Usage
+//
+//	req, _ := http.NewRequestWithContext(t.Context(), "GET", server.URL+"/api/v1/users/1", http.NoBody)
+//	assertions.HTTPRoundTripBodyJSONEq(t, myTransport, req, `{"id": 1, "name": "Alice"}`)
+//
+// # Examples
+//
+//	success: httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`
+//	failure: httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`
+func HTTPRoundTripBodyJSONEq(t T, rt http.RoundTripper, req *http.Request, expected string, msgAndArgs ...any) bool {
+	// Domain: http
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if req == nil || req.URL == nil {
+		return Fail(t, "a non-nil request with a URL is required", msgAndArgs...)
+	}
+
+	_, body, err := httpRoundTrip(rt, req)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("HTTP round trip failed for %q: %v", req.URL, err), msgAndArgs...)
+	}
+
+	return JSONEqBytes(t, []byte(expected), body, msgAndArgs...)
+}
+
 func httpCodeInRange(handler http.HandlerFunc, minStatusCode, maxStatusCode int, expectedStatus, method, url string, values url.Values) error {
 	code, err := httpCode(handler, method, url, values)
 	if err != nil {
@@ -205,15 +355,50 @@ func httpCodeInRange(handler http.HandlerFunc, minStatusCode, maxStatusCode int,
 //
 // It returns -1 and an error if building a new request fails.
 func httpCode(handler http.HandlerFunc, method, url string, values url.Values) (int, error) {
+	w, err := httpRecord(handler, method, url, values)
+	if err != nil {
+		return -1, err
+	}
+
+	return w.Code, nil
+}
+
+// httpRecord is a helper that records the response of the handler.
+//
+// It returns an error if building a new request fails.
+func httpRecord(handler http.HandlerFunc, method, url string, values url.Values) (*httptest.ResponseRecorder, error) {
 	// maintainer: should inject t.Context()
 	w := httptest.NewRecorder()
 	req, err := http.NewRequestWithContext(context.Background(), method, url, http.NoBody)
 	if err != nil {
-		return -1, err
+		return nil, err
 	}
 
 	req.URL.RawQuery = values.Encode()
 	handler(w, req)
 
-	return w.Code, nil
+	return w, nil
+}
+
+// httpRoundTrip is a helper that sends a request through a round tripper,
+// and returns the status code and the body of the response.
+func httpRoundTrip(rt http.RoundTripper, req *http.Request) (int, []byte, error) {
+	if rt == nil {
+		rt = http.DefaultTransport
+	}
+
+	resp, err := rt.RoundTrip(req)
+	if err != nil {
+		return -1, nil, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return -1, nil, err
+	}
+
+	return resp.StatusCode, body, nil
 }
@@ -4,10 +4,13 @@
 package assertions
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"io"
 	"iter"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"slices"
 	"testing"
@@ -35,6 +38,50 @@ func TestHTTPBody(t *testing.T) {
 	}
 }
 
+func TestHTTPJSON(t *testing.T) {
+	t.Parallel()
+
+	for tc := range httpJSONCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			res := tc.assertion(mock)
+			shouldPassOrFail(t, mock, res, tc.success)
+		})
+	}
+}
+
+func TestHTTPRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with default transport", func(t *testing.T) {
+		t.Parallel()
+
+		server := httptest.NewServer(http.HandlerFunc(httpJSON))
+		t.Cleanup(server.Close)
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, server.URL, http.NoBody)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		mock := new(mockT)
+		res := HTTPRoundTripStatusCode(mock, nil, req, http.StatusOK)
+		shouldPassOrFail(t, mock, res, true)
+	})
+
+	for tc := range httpRoundTripCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			res := tc.assertion(mock)
+			shouldPassOrFail(t, mock, res, tc.success)
+		})
+	}
+}
+
 func TestHTTPErrorMessages(t *testing.T) {
 	t.Parallel()
 
@@ -223,6 +270,131 @@ func expectedHTTPBody(assertion httpBodyAssertionKind, kind bodyMatchKind) bool
 	}
 }
 
+// ============================================================================
+// HTTP JSON and round trip tests
+// ============================================================================
+
+type httpAssertionCase struct {
+	name      string
+	assertion func(T) bool
+	success   bool
+}
+
+func httpJSONCases() iter.Seq[httpAssertionCase] {
+	return slices.Values([]httpAssertionCase{
+		{
+			name:      "HTTPBodyJSONEq/equivalent",
+			assertion: func(t T) bool { return HTTPBodyJSONEq(t, httpJSON, "GET", "/", nil, `{ "hello" : "world" }`) },
+			success:   true,
+		},
+		{
+			name:      "HTTPBodyJSONEq/different",
+			assertion: func(t T) bool { return HTTPBodyJSONEq(t, httpJSON, "GET", "/", nil, `{"hello": "bob"}`) },
+		},
+		{
+			name:      "HTTPBodyJSONEq/not-json",
+			assertion: func(t T) bool { return HTTPBodyJSONEq(t, httpHelloName, "GET", "/", nil, `{"hello": "world"}`) },
+		},
+		{
+			name:      "HTTPBodyJSONEq/bad-method",
+			assertion: func(t T) bool { return HTTPBodyJSONEq(t, httpJSON, "BAD METHOD", "/", nil, `{"hello": "world"}`) },
+		},
+		{
+			name:      "HTTPHeader/match",
+			assertion: func(t T) bool { return HTTPHeader(t, httpJSON, "GET", "/", nil, "content-type", "application/json") },
+			success:   true,
+		},
+		{
+			name:      "HTTPHeader/mismatch",
+			assertion: func(t T) bool { return HTTPHeader(t, httpJSON, "GET", "/", nil, "Content-Type", "text/plain") },
+		},
+		{
+			name:      "HTTPHeader/missing",
+			assertion: func(t T) bool { return HTTPHeader(t, httpOK, "GET", "/", nil, "X-Request-Id", "1") },
+		},
+		{
+			name:      "HTTPHeader/set-with-no-value",
+			assertion: func(t T) bool { return HTTPHeader(t, httpNoContentType, "GET", "/", nil, "Content-Type", "") },
+			success:   true,
+		},
+		{
+			name: "HTTPHeader/set-with-no-value-mismatch",
+			assertion: func(t T) bool {
+				return HTTPHeader(t, httpNoContentType, "GET", "/", nil, "Content-Type", "text/plain")
+			},
+		},
+		{
+			name: "HTTPHeader/bad-method",
+			assertion: func(t T) bool {
+				return HTTPHeader(t, httpJSON, "BAD METHOD", "/", nil, "Content-Type", "application/json")
+			},
+		},
+	})
+}
+
+func httpRoundTripCases() iter.Seq[httpAssertionCase] {
+	newRequest := func() *http.Request {
+		return httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/", http.NoBody)
+	}
+
+	return slices.Values([]httpAssertionCase{
+		{
+			name: "HTTPRoundTripStatusCode/match",
+			assertion: func(t T) bool {
+				return HTTPRoundTripStatusCode(t, httpRoundTripper(httpOK), newRequest(), http.StatusOK)
+			},
+			success: true,
+		},
+		{
+			name: "HTTPRoundTripStatusCode/mismatch",
+			assertion: func(t T) bool {
+				return HTTPRoundTripStatusCode(t, httpRoundTripper(httpError), newRequest(), http.StatusOK)
+			},
+		},
+		{
+			name: "HTTPRoundTripStatusCode/transport-error",
+			assertion: func(t T) bool {
+				return HTTPRoundTripStatusCode(t, httpFailingRoundTripper{}, newRequest(), http.StatusOK)
+			},
+		},
+		{
+			name:      "HTTPRoundTripStatusCode/nil-request",
+			assertion: func(t T) bool { return HTTPRoundTripStatusCode(t, nil, nil, http.StatusOK) },
+		},
+		{
+			name: "HTTPRoundTripStatusCode/nil-url",
+			assertion: func(t T) bool {
+				return HTTPRoundTripStatusCode(t, httpRoundTripper(httpOK), &http.Request{}, http.StatusOK)
+			},
+		},
+		{
+			name: "HTTPRoundTripBodyJSONEq/equivalent",
+			assertion: func(t T) bool {
+				return HTTPRoundTripBodyJSONEq(t, httpRoundTripper(httpJSON), newRequest(), `{"hello":"world"}`)
+			},
+			success: true,
+		},
+		{
+			name: "HTTPRoundTripBodyJSONEq/different",
+			assertion: func(t T) bool {
+				return HTTPRoundTripBodyJSONEq(t, httpRoundTripper(httpJSON), newRequest(), `{"hello":"bob"}`)
+			},
+		},
+		{
+			name: "HTTPRoundTripBodyJSONEq/transport-error",
+			assertion: func(t T) bool {
+				return HTTPRoundTripBodyJSONEq(t, httpFailingRoundTripper{}, newRequest(), `{"hello":"world"}`)
+			},
+		},
+		{
+			name: "HTTPRoundTripBodyJSONEq/nil-request",
+			assertion: func(t T) bool {
+				return HTTPRoundTripBodyJSONEq(t, httpRoundTripper(httpJSON), nil, `{"hello":"world"}`)
+			},
+		},
+	})
+}
+
 // ============================================================================
 // Error message tests
 // ============================================================================
@@ -244,6 +416,39 @@ func httpFailCases() iter.Seq[failCase] {
 			assertion:    func(t T) bool { return HTTPError(t, httpRedirect, "GET", "/", nil) },
 			wantContains: []string{"expected HTTP error status code"},
 		},
+		{
+			name:         "HTTPHeader/mismatch",
+			assertion:    func(t T) bool { return HTTPHeader(t, httpJSON, "GET", "/", nil, "Content-Type", "text/plain") },
+			wantContains: []string{`Expected response header "Content-Type" for "/?" to be "text/plain" but found "application/json"`},
+		},
+		{
+			name:         "HTTPHeader/missing",
+			assertion:    func(t T) bool { return HTTPHeader(t, httpOK, "GET", "/", nil, "X-Request-Id", "1") },
+			wantContains: []string{`Expected response header "X-Request-Id" for "/?" to be "1" but it is not set`},
+		},
+		{
+			name: "HTTPHeader/set-with-no-value",
+			assertion: func(t T) bool {
+				return HTTPHeader(t, httpNoContentType, "GET", "/", nil, "Content-Type", "text/plain")
+			},
+			wantContains: []string{`Expected response header "Content-Type" for "/?" to be "text/plain" but it is set with no value`},
+		},
+		{
+			name: "HTTPRoundTripStatusCode/mismatch",
+			assertion: func(t T) bool {
+				req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/health", http.NoBody)
+				return HTTPRoundTripStatusCode(t, httpRoundTripper(httpError), req, http.StatusOK)
+			},
+			wantContains: []string{`expected HTTP status code 200 for "/health" but received 500`},
+		},
+		{
+			name: "HTTPRoundTripStatusCode/transport-error",
+			assertion: func(t T) bool {
+				req := httptest.NewRequestWithContext(context.Background(), http.MethodGet, "/health", http.NoBody)
+				return HTTPRoundTripStatusCode(t, httpFailingRoundTripper{}, req, http.StatusOK)
+			},
+			wantContains: []string{`HTTP round trip failed for "/health": connection refused`},
+		},
 	})
 }
 
@@ -278,3 +483,30 @@ func httpError(w http.ResponseWriter, _ *http.Request) {
 func httpStatusCode(w http.ResponseWriter, _ *http.Request) {
 	w.WriteHeader(http.StatusSwitchingProtocols)
 }
+
+func httpJSON(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"hello": "world"}`)
+}
+
+// httpNoContentType suppresses the Content-Type header otherwise sniffed from the body.
+func httpNoContentType(w http.ResponseWriter, _ *http.Request) {
+	w.Header()["Content-Type"] = nil
+	_, _ = fmt.Fprint(w, "hello")
+}
+
+// httpRoundTripper serves requests with a handler, in place of a client transport.
+type httpRoundTripper http.HandlerFunc
+
+func (f httpRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	f(w, r)
+
+	return w.Result(), nil
+}
+
+type httpFailingRoundTripper struct{}
+
+func (httpFailingRoundTripper) RoundTrip(*http.Request) (*http.Response, error) {
+	return nil, errors.New("connection refused")
+}
@@ -42,6 +42,10 @@ type namer interface {
 	Name() string
 }
 
+type cleaner interface {
+	Cleanup(func())
+}
+
 type contextualizer interface {
 	Context() context.Context
 }
@@ -96,6 +96,63 @@ func JSONEqT[EDoc, ADoc RText](t T, expected EDoc, actual ADoc, msgAndArgs ...an
 	return JSONEqBytes(t, asBytes(expected), asBytes(actual), msgAndArgs...)
 }
 
+// JSONMatches asserts that a JSON document matches the expected JSON document,
+// ignoring any field in actual that is not present in expected.
+//
+// Objects in actual may contain more fields than objects in expected.
+// Arrays must have the same length and their elements are matched in order,
+// following the same rule. Other values must be equal.
+//
+// This is useful to check the relevant part of an API response.
+//
+// Expected and actual must be valid JSON.
+//
+// For dynamic redaction of the input text via a callback, use [JSONMatchesT].
+//
+// # Usage
+//
+//	assertions.JSONMatches(t, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+//
+// # Examples
+//
+//	success: `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`
+//	failure: `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`
+func JSONMatches(t T, expected, actual string, msgAndArgs ...any) bool {
+	// Domain: json
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	return jsonMatchesBytes(t, []byte(expected), []byte(actual), msgAndArgs...)
+}
+
+// JSONMatchesT asserts that a JSON document matches the expected JSON document,
+// ignoring any field in actual that is not present in expected.
+//
+// See [JSONMatches] for the matching rules.
+//
+// The expected and actual arguments may be string or []byte (e.g. [json.RawMessage]).
+// They do not need to be of the same type.
+//
+// NOTE: passed values (expected, actual) may be wrapped as functions to redact the input text dynamically.
+//
+// # Usage
+//
+//	assertions.JSONMatchesT(t, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+//
+// # Examples
+//
+//	success: `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`)
+//	failure: `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`)
+func JSONMatchesT[EDoc, ADoc RText](t T, expected EDoc, actual ADoc, msgAndArgs ...any) bool {
+	// Domain: json
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	return jsonMatchesBytes(t, asBytes(expected), asBytes(actual), msgAndArgs...)
+}
+
 // JSONUnmarshalAsT wraps [Equal] after [json.Unmarshal].
 //
 // The input JSON may be a string or []byte.
@@ -172,6 +229,60 @@ func JSONMarshalAsT[EDoc RText](t T, expected EDoc, object any, msgAndArgs ...an
 	return JSONEqBytes(t, asBytes(expected), actual, msgAndArgs...)
 }
 
+func jsonMatchesBytes(t T, expected, actual []byte, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	var expectedJSONAsInterface, actualJSONAsInterface any
+
+	if err := json.Unmarshal(expected, &expectedJSONAsInterface); err != nil {
+		return Fail(t, fmt.Sprintf("Expected value (%q) is not valid json.\nJSON parsing error: %v", expected, err), msgAndArgs...)
+	}
+
+	if err := json.Unmarshal(actual, &actualJSONAsInterface); err != nil {
+		return Fail(t, fmt.Sprintf("Input (%q) needs to be valid json.\nJSON parsing error: %v", actual, err), msgAndArgs...)
+	}
+
+	return Equal(t, expectedJSONAsInterface, jsonProject(expectedJSONAsInterface, actualJSONAsInterface), msgAndArgs...)
+}
+
+// jsonProject returns a copy of the actual unmarshaled JSON value, restricted to the object
+// keys present in the expected value.
+//
+// Values with a different shape than expected are returned unchanged, so the comparison reports them.
+func jsonProject(expected, actual any) any {
+	switch e := expected.(type) {
+	case map[string]any:
+		a, ok := actual.(map[string]any)
+		if !ok {
+			return actual
+		}
+
+		projected := make(map[string]any, len(e))
+		for key, value := range e {
+			if actualValue, found := a[key]; found {
+				projected[key] = jsonProject(value, actualValue)
+			}
+		}
+
+		return projected
+	case []any:
+		a, ok := actual.([]any)
+		if !ok || len(a) != len(e) {
+			return actual
+		}
+
+		projected := make([]any, len(a))
+		for i := range a {
+			projected[i] = jsonProject(e[i], a[i])
+		}
+
+		return projected
+	default:
+		return actual
+	}
+}
+
 func asBytes[EDoc RText](e EDoc) []byte {
 	ie := any(e)
 
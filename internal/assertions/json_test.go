@@ -4,6 +4,7 @@
 package assertions
 
 import (
+	"encoding/json"
 	"iter"
 	"slices"
 	"strings"
@@ -19,6 +20,14 @@ func TestJSONEq(t *testing.T) {
 	}
 }
 
+func TestJSONMatches(t *testing.T) {
+	t.Parallel()
+
+	for tc := range jsonMatchesCases() {
+		t.Run(tc.name, tc.test)
+	}
+}
+
 func TestJSONMarshalUnmarshalAs(t *testing.T) {
 	t.Parallel()
 
@@ -46,6 +55,7 @@ func testAllJSONEq(expected, actual string, success bool) func(*testing.T) {
 		t.Run("with JSONEqT[string,[]byte]", testJSONEqT[string, []byte](expected, actual, success))
 		t.Run("with JSONEqT[byte,byte]", testJSONEqT[[]byte, []byte](expected, actual, success))
 		t.Run("with JSONEqT[string,string]", testJSONEqT[string, string](expected, actual, success))
+		t.Run("with JSONEqT[json.RawMessage,[]byte]", testJSONEqT[json.RawMessage, []byte](expected, actual, success))
 	}
 }
 
@@ -164,6 +174,127 @@ func jsonCases() iter.Seq[genericTestCase] {
 	})
 }
 
+// =======================================
+// Test JSONMatches / JSONMatchesT
+// =======================================
+
+// test all JSONMatches variants with the same input (possibly converted).
+func testAllJSONMatches(expected, actual string, success bool) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Run("with JSONMatches", testJSONMatches(expected, actual, success))
+		t.Run("with JSONMatchesT[string,[]byte]", testJSONMatchesT[string, []byte](expected, actual, success))
+		t.Run("with JSONMatchesT[[]byte,json.RawMessage]", testJSONMatchesT[[]byte, json.RawMessage](expected, actual, success))
+		t.Run("with JSONMatchesT[string,string]", testJSONMatchesT[string, string](expected, actual, success))
+	}
+}
+
+func testJSONMatches(expected, actual string, success bool) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		res := JSONMatches(mock, expected, actual)
+		if res != success {
+			if success {
+				croakWantJSONMatch(t, expected, actual)
+				return
+			}
+			croakWantJSONNotMatch(t, expected, actual)
+		}
+	}
+}
+
+func testJSONMatchesT[EDoc, ADoc Text](expected, actual string, success bool) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		res := JSONMatchesT(mock, EDoc(expected), ADoc(actual))
+		if res != success {
+			if success {
+				croakWantJSONMatch(t, expected, actual)
+				return
+			}
+			croakWantJSONNotMatch(t, expected, actual)
+		}
+	}
+}
+
+func croakWantJSONMatch(t *testing.T, expected, actual string) {
+	t.Helper()
+	t.Errorf("expected %q to match %q", actual, expected)
+}
+
+func croakWantJSONNotMatch(t *testing.T, expected, actual string) {
+	t.Helper()
+	t.Errorf("expected %q NOT to match %q", actual, expected)
+}
+
+func jsonMatchesCases() iter.Seq[genericTestCase] {
+	return slices.Values([]genericTestCase{
+		{"should match equivalent JSON", testAllJSONMatches(
+			`{"hello": "world", "foo": "bar"}`,
+			`{"foo": "bar", "hello": "world"}`,
+			true,
+		)},
+		{"should match with extra fields", testAllJSONMatches(
+			`{"hello": "world"}`,
+			`{"foo": "bar", "hello": "world"}`,
+			true,
+		)},
+		{"should match with extra nested fields", testAllJSONMatches(
+			`{"data": {"id": 1, "tags": [{"name": "a"}, {"name": "b"}]}}`,
+			`{"status": "ok", "data": {"id": 1, "created": "now", "tags": [{"name": "a", "n": 1}, {"name": "b", "n": 2}]}}`,
+			true,
+		)},
+		{"should match empty object", testAllJSONMatches(
+			`{}`,
+			`{"hello": "world"}`,
+			true,
+		)},
+		{"should match scalars", testAllJSONMatches(
+			`"hello"`,
+			`"hello"`,
+			true,
+		)},
+		{"should not match missing field", testAllJSONMatches(
+			`{"hello": "world", "foo": "bar"}`,
+			`{"hello": "world"}`,
+			false,
+		)},
+		{"should not match different value", testAllJSONMatches(
+			`{"data": {"id": 1}}`,
+			`{"data": {"id": 2, "name": "x"}}`,
+			false,
+		)},
+		{"should not match arrays of different length", testAllJSONMatches(
+			`{"tags": [{"name": "a"}]}`,
+			`{"tags": [{"name": "a"}, {"name": "b"}]}`,
+			false,
+		)},
+		{"should not match arrays in a different order", testAllJSONMatches(
+			`["a", "b"]`,
+			`["b", "a"]`,
+			false,
+		)},
+		{"should not match different shapes", testAllJSONMatches(
+			`{"data": {"id": 1}}`,
+			`{"data": [1]}`,
+			false,
+		)},
+		{"should not match invalid expected", testAllJSONMatches(
+			`not json`,
+			`{"hello": "world"}`,
+			false,
+		)},
+		{"should not match invalid actual", testAllJSONMatches(
+			`{"hello": "world"}`,
+			`not json`,
+			false,
+		)},
+	})
+}
+
 // =======================================
 // Test JSONMarshalAsT / JSONUnmarshalAsT
 // =======================================
@@ -280,6 +411,21 @@ func jsonFailCases() iter.Seq[failCase] {
 			assertion:    func(t T) bool { return JSONEq(t, part1, "not json") },
 			wantContains: []string{"needs to be valid json"},
 		},
+		{
+			name:         "JSONMatches/missing-field",
+			assertion:    func(t T) bool { return JSONMatches(t, `{"a":1,"b":2}`, `{"a":1,"c":3}`) },
+			wantContains: []string{"Not equal", `"b"`},
+		},
+		{
+			name:         "JSONMatches/invalid-expected",
+			assertion:    func(t T) bool { return JSONMatches(t, "not json", part1) },
+			wantContains: []string{"is not valid json"},
+		},
+		{
+			name:         "JSONMatches/invalid-actual",
+			assertion:    func(t T) bool { return JSONMatches(t, part1, "not json") },
+			wantContains: []string{"needs to be valid json"},
+		},
 		{
 			name: "JSONEqT/redactor-mismatch",
 			assertion: func(t T) bool {
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"iter"
 	"regexp"
+	"runtime"
 	"strings"
 	"testing"
 )
@@ -24,6 +25,7 @@ type mockT struct {
 	errorFmt string
 	args     []any
 	failed   bool
+	cleanups []func()
 }
 
 const (
@@ -40,6 +42,11 @@ func (m *mockT) Errorf(format string, args ...any) {
 	m.failed = true
 }
 
+// Cleanup records the function, so options may be applied to the mock.
+func (m *mockT) Cleanup(fn func()) {
+	m.cleanups = append(m.cleanups, fn)
+}
+
 func (m *mockT) Failed() bool {
 	return m.errorFmt != "" || m.failed
 }
@@ -86,13 +93,19 @@ func (t *errorsCapturingT) Errorf(format string, args ...any) {
 }
 
 type captureT struct {
-	failed bool
-	msg    string
+	failed   bool
+	msg      string
+	cleanups []func()
 }
 
 // Helper is like [testing.T.Helper] but does nothing.
 func (captureT) Helper() {}
 
+// Cleanup records the function, so options may be applied to the mock.
+func (ctt *captureT) Cleanup(fn func()) {
+	ctt.cleanups = append(ctt.cleanups, fn)
+}
+
 func (ctt *captureT) Errorf(format string, args ...any) {
 	ctt.msg = fmt.Sprintf(format, args...)
 	ctt.failed = true
@@ -114,6 +127,15 @@ func shouldPassOrFail(t *testing.T, mock *mockT, result, shouldPass bool) {
 	}
 }
 
+// failAt fails t from this file, which is not filtered out of call stacks by [callerInfo],
+// and returns the location of the failure.
+func failAt(t T) (file string, line int) {
+	_, file, line, _ = runtime.Caller(0)
+	Fail(t, "failed") // must be on the line following runtime.Caller
+
+	return file, line + 1
+}
+
 func ptr(i int) *int {
 	return &i
 }
@@ -0,0 +1,108 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"reflect"
+	"slices"
+	"sync"
+)
+
+// testConfigs holds the configuration set by options for a test, keyed by [T].
+//
+//nolint:gochecknoglobals // options are configured per test and must be found from any assertion called with this T
+var testConfigs sync.Map
+
+// Option configures the assertions run with a given [T].
+//
+// Options are passed to [New]. They may also be applied directly to a test, e.g. when using
+// assertion functions rather than methods:
+//
+//	assert.WithComparers(comparers...)(t)
+//
+// The configuration is removed when the test completes: options require a [T] supporting Cleanup,
+// such as [testing.T]. Applying an option to another [T] reports an error.
+type Option func(T)
+
+// testConfig is the configuration of the assertions run with a given [T].
+type testConfig struct {
+	comparers     []Comparer
+	reporters     []FailureReporter
+	ignoredFields []string
+}
+
+// configure updates the configuration for t.
+//
+// The configuration is removed when the test completes: t must support [testing.T.Cleanup].
+// A [CollectT] holds its own configuration.
+func configure(t T, update func(*testConfig)) {
+	if c, isCollector := t.(*CollectT); isCollector {
+		var cfg testConfig
+		if c.config != nil {
+			cfg = *c.config
+		}
+		cfg = updated(cfg, update)
+		c.config = &cfg
+
+		return
+	}
+
+	if !reflect.TypeOf(t).Comparable() {
+		t.Errorf("assertions cannot be configured: %T is not comparable", t)
+
+		return
+	}
+
+	c, ok := t.(cleaner)
+	if !ok {
+		t.Errorf("assertions cannot be configured: %T does not support Cleanup", t)
+
+		return
+	}
+
+	var cfg testConfig
+	existing, isConfigured := testConfigs.Load(t)
+	if isConfigured {
+		cfg = existing.(testConfig) //nolint:forcetypeassert // the registry only stores testConfig
+	}
+
+	testConfigs.Store(t, updated(cfg, update))
+
+	if !isConfigured {
+		c.Cleanup(func() {
+			testConfigs.Delete(t)
+		})
+	}
+}
+
+// updated returns a copy of cfg with the update applied: configurations are never mutated.
+func updated(cfg testConfig, update func(*testConfig)) testConfig {
+	cfg.comparers = slices.Clip(cfg.comparers)
+	cfg.reporters = slices.Clip(cfg.reporters)
+	cfg.ignoredFields = slices.Clip(cfg.ignoredFields)
+	update(&cfg)
+
+	return cfg
+}
+
+func configFor(t T) (testConfig, bool) {
+	if c, isCollector := t.(*CollectT); isCollector {
+		if c.config == nil {
+			return testConfig{}, false
+		}
+
+		return *c.config, true
+	}
+
+	if t == nil || !reflect.TypeOf(t).Comparable() {
+		return testConfig{}, false
+	}
+
+	cfg, ok := testConfigs.Load(t)
+	if !ok {
+		return testConfig{}, false
+	}
+
+	return cfg.(testConfig), true //nolint:forcetypeassert // the registry only stores testConfig
+}
@@ -4,7 +4,9 @@
 package assertions
 
 import (
+	"errors"
 	"fmt"
+	"runtime"
 	"runtime/debug"
 )
 
@@ -99,6 +101,81 @@ func PanicsWithError(t T, errString string, f func(), msgAndArgs ...any) bool {
 	return true
 }
 
+// PanicsWithErrorIs asserts that the code inside the specified function panics,
+// and that the recovered panic value is an error that satisfies the [ErrorIs] comparison.
+//
+// This is useful when the panic value wraps a sentinel error.
+//
+// # Usage
+//
+//	assertions.PanicsWithErrorIs(t, ErrCrazy, func(){ GoCrazy() })
+//
+// # Examples
+//
+//	success: ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }
+//	failure: ErrTest, func() { }
+func PanicsWithErrorIs(t T, target error, f func(), msgAndArgs ...any) bool {
+	// Domain: panic
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	funcDidPanic, panicValue, panickedStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should panic\n\tPanic value:\t%#v", panicValue), msgAndArgs...)
+	}
+	panicErr, isError := panicValue.(error)
+	if !isError || !errors.Is(panicErr, target) {
+		msg := fmt.Sprintf("func should panic with an error in the chain of:\t%#v\n", target)
+		if isError {
+			msg += fmt.Sprintf("\tError chain:\t%s\n", buildErrorChainString(panicErr, false))
+		}
+		msg += fmt.Sprintf("\tPanic value:\t%#v\n", panicValue)
+		msg += fmt.Sprintf("\tPanic stack:\t%s\n", panickedStack)
+		return Fail(t, msg, msgAndArgs...)
+	}
+
+	return true
+}
+
+// PanicsMatching asserts that the code inside the specified function panics,
+// and that the recovered panic value satisfies the matcher function.
+//
+// This is useful to check panics carrying structured values, without
+// re-implementing the recover logic.
+//
+// # Usage
+//
+//	assertions.PanicsMatching(t, func(v any) bool {
+//		e, ok := v.(*MyPanic)
+//		return ok && e.Code == 42
+//	}, func(){ GoCrazy() })
+//
+// # Examples
+//
+//	success: func(v any) bool { return v == "panicking" }, func() { panic("panicking") }
+//	failure: func(v any) bool { return v == "panicking" }, func() { panic("other") }
+func PanicsMatching(t T, matcher func(any) bool, f func(), msgAndArgs ...any) bool {
+	// Domain: panic
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if matcher == nil {
+		return Fail(t, "a non-nil matcher is required", msgAndArgs...)
+	}
+
+	funcDidPanic, panicValue, panickedStack := didPanic(f)
+	if !funcDidPanic {
+		return Fail(t, fmt.Sprintf("func should panic\n\tPanic value:\t%#v", panicValue), msgAndArgs...)
+	}
+	if !matcher(panicValue) {
+		return Fail(t, fmt.Sprintf("func should panic with a value matching the matcher\n\tPanic value:\t%#v\n\tPanic stack:\t%s", panicValue, panickedStack), msgAndArgs...)
+	}
+
+	return true
+}
+
 // NotPanics asserts that the code inside the specified function does NOT panic.
 //
 // # Usage
@@ -133,10 +210,8 @@ func didPanic(f func()) (didPanic bool, message any, stack string) {
 		}
 		// Go 1.21 introduces runtime.PanicNilError on panic(nil),
 		// so maintain the same logic going forward (https://github.com/golang/go/issues/25448).
-		if err, ok := message.(error); ok {
-			if err.Error() == "panic called with nil argument" {
-				message = nil
-			}
+		if _, ok := message.(*runtime.PanicNilError); ok {
+			message = nil
 		}
 	}()
 
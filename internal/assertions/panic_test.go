@@ -5,8 +5,10 @@ package assertions
 
 import (
 	"errors"
+	"fmt"
 	"io"
 	"iter"
+	"runtime"
 	"slices"
 	"strings"
 	"testing"
@@ -50,6 +52,20 @@ func TestPanicDidPanic(t *testing.T) {
 		}
 	}
 
+	{
+		// only panic(nil) is normalized, not a panic value wrapping a runtime.PanicNilError
+		wrapped := fmt.Errorf("wrapped: %w", new(runtime.PanicNilError))
+		funcDidPanic, msg, _ := didPanic(func() {
+			panic(wrapped)
+		})
+		if !funcDidPanic {
+			t.Error("didPanic should have panicked")
+		}
+		if msg != wrapped {
+			t.Errorf("didPanic should have returned the panic value, got %v", msg)
+		}
+	}
+
 	if funcDidPanic, _, _ := didPanic(func() {
 	}); funcDidPanic {
 		t.Error("didPanic should return false")
@@ -113,6 +129,66 @@ func TestPanicsWithError(t *testing.T) {
 	shouldPassOrFail(t, mock, succeeded, true)
 }
 
+func TestPanicsWithErrorIs(t *testing.T) {
+	t.Parallel()
+	mock := new(mockT)
+
+	if !PanicsWithErrorIs(mock, io.EOF, func() {
+		panic(fmt.Errorf("wrapped: %w", io.EOF))
+	}) {
+		t.Error("PanicsWithErrorIs should return true")
+	}
+
+	if PanicsWithErrorIs(mock, io.EOF, func() {
+	}) {
+		t.Error("PanicsWithErrorIs should return false")
+	}
+
+	if PanicsWithErrorIs(mock, io.EOF, func() {
+		panic(io.ErrUnexpectedEOF)
+	}) {
+		t.Error("PanicsWithErrorIs should return false")
+	}
+
+	if PanicsWithErrorIs(mock, io.EOF, func() {
+		panic("EOF")
+	}) {
+		t.Error("PanicsWithErrorIs should return false")
+	}
+}
+
+func TestPanicsMatching(t *testing.T) {
+	t.Parallel()
+	mock := new(mockT)
+	hasPrefix := func(v any) bool {
+		s, ok := v.(PanicsWrapperError)
+		return ok && s.Prefix == "wrapped"
+	}
+
+	if !PanicsMatching(mock, hasPrefix, func() {
+		panic(PanicsWrapperError{Prefix: "wrapped", Err: io.EOF})
+	}) {
+		t.Error("PanicsMatching should return true")
+	}
+
+	if PanicsMatching(mock, hasPrefix, func() {
+	}) {
+		t.Error("PanicsMatching should return false")
+	}
+
+	if PanicsMatching(mock, hasPrefix, func() {
+		panic(PanicsWrapperError{Prefix: "other", Err: io.EOF})
+	}) {
+		t.Error("PanicsMatching should return false")
+	}
+
+	if PanicsMatching(mock, nil, func() {
+		panic("panicking")
+	}) {
+		t.Error("PanicsMatching should return false with a nil matcher")
+	}
+}
+
 func TestPanicNotPanics(t *testing.T) {
 	t.Parallel()
 	mock := new(mockT)
@@ -189,6 +265,38 @@ func panicsWithErrorFailCases() iter.Seq[failCase] {
 			},
 			wantContains: []string{"Error message:", "wrapped: actual panic err msg"},
 		},
+		{
+			name:         "PanicsWithErrorIs/no-panic",
+			assertion:    func(t T) bool { return PanicsWithErrorIs(t, io.EOF, func() {}) },
+			wantContains: []string{"func should panic", "Panic value:"},
+		},
+		{
+			name: "PanicsWithErrorIs/wrong-error",
+			assertion: func(t T) bool {
+				return PanicsWithErrorIs(t, io.EOF, func() {
+					panic(fmt.Errorf("wrapped: %w", io.ErrUnexpectedEOF))
+				})
+			},
+			wantContains: []string{"func should panic with an error in the chain of:", "Error chain:", `"wrapped: unexpected EOF"`},
+		},
+		{
+			name: "PanicsMatching/no-match",
+			assertion: func(t T) bool {
+				return PanicsMatching(t, func(any) bool { return false }, func() {
+					panic("actual panic msg")
+				})
+			},
+			wantContains: []string{"func should panic with a value matching the matcher", "Panic value:", "actual panic msg"},
+		},
+		{
+			name: "PanicsMatching/nil-matcher",
+			assertion: func(t T) bool {
+				return PanicsMatching(t, nil, func() {
+					panic("actual panic msg")
+				})
+			},
+			wantError: "a non-nil matcher is required",
+		},
 		{
 			name: "PanicsWithError/string-panic",
 			assertion: func(t T) bool {
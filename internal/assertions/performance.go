@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+)
+
+// allocsRuns is the number of runs used by [MaxAllocsPerRun] to average allocations.
+const allocsRuns = 100
+
+// MaxAllocsPerRun asserts that the function allocates at most maxAllocs times per run.
+//
+// The number of allocations is averaged over 100 runs with [testing.AllocsPerRun],
+// after a warm-up run.
+//
+// The measured and maximum numbers of allocations are reported as the actual and expected values
+// of the [Failure] passed to [FailureReporter] hooks.
+//
+// # Concurrency
+//
+// [testing.AllocsPerRun] sets GOMAXPROCS to 1 while measuring: [MaxAllocsPerRun] cannot be
+// used while parallel tests are running, and fails in that case.
+//
+// Allocations are not reliably measured when running with the race detector.
+//
+// # Usage
+//
+//	assertions.MaxAllocsPerRun(t, 0, func() {
+//		_ = strconv.Itoa(42)
+//	})
+//
+// # Examples
+//
+//	success: 0, func() {}
+//	failure: 0, func() { _ = make([]byte, 1<<20) }
+func MaxAllocsPerRun(t T, maxAllocs int, fn func(), msgAndArgs ...any) bool {
+	// Domain: performance
+	// Serial: testing.AllocsPerRun must not run in parallel tests
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if fn == nil {
+		return Fail(t, "a non-nil function is required", msgAndArgs...)
+	}
+
+	allocs, err := allocsPerRun(fn)
+	if err != nil {
+		return Fail(t, err.Error(), msgAndArgs...)
+	}
+
+	if allocs <= float64(maxAllocs) {
+		return true
+	}
+
+	return failWith(t,
+		fmt.Sprintf("Too many allocations: expected at most %d allocation(s) per run, but got %v", maxAllocs, allocs),
+		Failure{Expected: maxAllocs, Actual: allocs},
+		msgAndArgs...,
+	)
+}
+
+// allocsPerRun wraps [testing.AllocsPerRun], which panics when called while parallel tests are running.
+//
+// Other panics, e.g. from the measured function, are propagated.
+func allocsPerRun(fn func()) (allocs float64, err error) {
+	const parallelPanic = "testing: AllocsPerRun called during parallel test"
+
+	defer func() {
+		if r := recover(); r != nil {
+			if r != parallelPanic { //nolint:errorlint // this panic value is a string
+				panic(r)
+			}
+
+			err = errors.New("allocations cannot be measured while parallel tests are running")
+		}
+	}()
+
+	return testing.AllocsPerRun(allocsRuns, fn), nil
+}
+
+// CompletesWithin asserts that the function completes within the given duration.
+//
+// The elapsed time is measured with the monotonic clock.
+//
+// The assertion fails as soon as the duration is exceeded, without waiting for the function to complete:
+// in that case the function keeps running in the background.
+//
+// A panic in the function is propagated if it occurs before the duration is exceeded.
+// The assertion fails if the function exits with [runtime.Goexit], e.g. after calling FailNow.
+//
+// The maximum and measured durations are reported as the expected and actual values
+// of the [Failure] passed to [FailureReporter] hooks.
+//
+// # Usage
+//
+//	assertions.CompletesWithin(t, 100*time.Millisecond, func() {
+//		_ = parse(input)
+//	})
+//
+// # Examples
+//
+//	success: time.Second, func() {}
+//	failure: 10*time.Millisecond, func() { time.Sleep(100*time.Millisecond) }
+func CompletesWithin(t T, d time.Duration, fn func(), msgAndArgs ...any) bool {
+	// Domain: performance
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if fn == nil {
+		return Fail(t, "a non-nil function is required", msgAndArgs...)
+	}
+
+	type outcome struct {
+		elapsed   time.Duration
+		recovered any
+		panicked  bool
+		exited    bool
+	}
+
+	done := make(chan outcome, 1)
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	start := time.Now()
+	go func() {
+		var (
+			result    outcome
+			completed bool
+		)
+		defer func() {
+			result.elapsed = time.Since(start)
+			if r := recover(); r != nil {
+				result.recovered, result.panicked = r, true
+			} else if !completed {
+				result.exited = true
+			}
+			done <- result
+		}()
+
+		fn()
+		completed = true
+	}()
+
+	select {
+	case result := <-done:
+		if result.panicked {
+			panic(result.recovered)
+		}
+
+		if result.exited {
+			return failWith(t,
+				fmt.Sprintf("Function did not complete: it exited with runtime.Goexit after %v", result.elapsed),
+				Failure{Expected: d, Actual: result.elapsed},
+				msgAndArgs...,
+			)
+		}
+
+		if result.elapsed <= d {
+			return true
+		}
+
+		return failWith(t,
+			fmt.Sprintf("Function did not complete within %v: completed after %v", d, result.elapsed),
+			Failure{Expected: d, Actual: result.elapsed},
+			msgAndArgs...,
+		)
+	case <-timer.C:
+		elapsed := time.Since(start)
+
+		return failWith(t,
+			fmt.Sprintf("Function did not complete within %v: still running after %v", d, elapsed),
+			Failure{Expected: d, Actual: elapsed},
+			msgAndArgs...,
+		)
+	}
+}
@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"iter"
+	"runtime"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+//nolint:gochecknoglobals // a package-level sink forces allocations to escape
+var performanceSink []byte
+
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
+func TestPerformanceMaxAllocsPerRun(t *testing.T) {
+	for tc := range maxAllocsPerRunCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := new(mockT)
+			res := MaxAllocsPerRun(mock, tc.maxAllocs, tc.fn)
+			shouldPassOrFail(t, mock, res, tc.pass)
+		})
+	}
+}
+
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
+func TestPerformanceMaxAllocsPerRunReporter(t *testing.T) {
+	mock := new(mockT)
+	var failure Failure
+	WithFailureReporter(func(f Failure) {
+		failure = f
+	})(mock)
+
+	MaxAllocsPerRun(mock, 0, func() {
+		performanceSink = make([]byte, 64)
+	})
+
+	if failure.Expected != 0 || failure.Actual != 1.0 {
+		t.Errorf("expected the allocations to be reported, got %#v and %#v", failure.Expected, failure.Actual)
+	}
+}
+
+//nolint:paralleltest // testing.AllocsPerRun must not run in parallel tests
+func TestPerformanceMaxAllocsPerRunErrorMessages(t *testing.T) {
+	for tc := range maxAllocsPerRunFailCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			mock := new(captureT)
+			tc.assertion(mock)
+
+			for _, want := range tc.wantContains {
+				if !strings.Contains(mock.msg, want) {
+					t.Errorf("expected failure message to contain %q, got:\n%s", want, mock.msg)
+				}
+			}
+		})
+	}
+}
+
+func TestPerformanceCompletesWithin(t *testing.T) {
+	t.Parallel()
+
+	for tc := range completesWithinCases() {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mock := new(mockT)
+			res := CompletesWithin(mock, tc.duration, tc.fn)
+			shouldPassOrFail(t, mock, res, tc.pass)
+		})
+	}
+
+	t.Run("should not wait for a function that does not complete", func(t *testing.T) {
+		t.Parallel()
+
+		release := make(chan struct{})
+		defer close(release)
+
+		mock := new(mockT)
+		start := time.Now()
+		res := CompletesWithin(mock, 10*time.Millisecond, func() {
+			<-release
+		})
+		shouldPassOrFail(t, mock, res, false)
+
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("expected the assertion to return early, but it took %v", elapsed)
+		}
+	})
+
+	t.Run("should propagate panics", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected the panic to be propagated, got %v", r)
+			}
+		}()
+
+		CompletesWithin(new(mockT), time.Second, func() {
+			panic("boom")
+		})
+	})
+
+	t.Run("should report durations", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		var failure Failure
+		WithFailureReporter(func(f Failure) {
+			failure = f
+		})(mock)
+
+		CompletesWithin(mock, time.Millisecond, func() {
+			time.Sleep(20 * time.Millisecond)
+		})
+
+		if failure.Expected != time.Millisecond {
+			t.Errorf("expected the budget to be reported, got %#v", failure.Expected)
+		}
+		if elapsed, ok := failure.Actual.(time.Duration); !ok || elapsed < time.Millisecond {
+			t.Errorf("expected the elapsed time to be reported, got %#v", failure.Actual)
+		}
+	})
+}
+
+func TestPerformanceErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	runFailCases(t, performanceFailCases())
+}
+
+type performanceCase struct {
+	name      string
+	maxAllocs int
+	duration  time.Duration
+	fn        func()
+	pass      bool
+}
+
+func maxAllocsPerRunCases() iter.Seq[performanceCase] {
+	return slices.Values([]performanceCase{
+		{
+			name:      "no-allocation",
+			maxAllocs: 0,
+			fn:        func() {},
+			pass:      true,
+		},
+		{
+			name:      "within-budget",
+			maxAllocs: 1,
+			fn:        func() { performanceSink = make([]byte, 64) },
+			pass:      true,
+		},
+		{
+			name:      "over-budget",
+			maxAllocs: 1,
+			fn: func() {
+				performanceSink = make([]byte, 64)
+				performanceSink = []byte(strconv.Itoa(len(performanceSink) * 1000))
+			},
+			pass: false,
+		},
+		{
+			name:      "nil-function",
+			maxAllocs: 1,
+			fn:        nil,
+			pass:      false,
+		},
+	})
+}
+
+func completesWithinCases() iter.Seq[performanceCase] {
+	return slices.Values([]performanceCase{
+		{
+			name:     "fast-function",
+			duration: time.Second,
+			fn:       func() {},
+			pass:     true,
+		},
+		{
+			name:     "slow-function",
+			duration: time.Millisecond,
+			fn:       func() { time.Sleep(50 * time.Millisecond) },
+			pass:     false,
+		},
+		{
+			name:     "function-exiting-goroutine",
+			duration: time.Second,
+			fn:       runtime.Goexit,
+			pass:     false,
+		},
+		{
+			name:     "nil-function",
+			duration: time.Second,
+			fn:       nil,
+			pass:     false,
+		},
+	})
+}
+
+func performanceFailCases() iter.Seq[failCase] {
+	return slices.Values([]failCase{
+		{
+			name: "completes-within/still-running",
+			assertion: func(t T) bool {
+				release := make(chan struct{})
+				defer close(release)
+
+				return CompletesWithin(t, time.Millisecond, func() { <-release })
+			},
+			wantContains: []string{"Function did not complete within 1ms: still running after"},
+		},
+		{
+			name: "completes-within/goexit",
+			assertion: func(t T) bool {
+				return CompletesWithin(t, time.Second, runtime.Goexit)
+			},
+			wantContains: []string{"Function did not complete: it exited with runtime.Goexit after"},
+		},
+	})
+}
+
+func maxAllocsPerRunFailCases() iter.Seq[failCase] {
+	return slices.Values([]failCase{
+		{
+			name: "over-budget",
+			assertion: func(t T) bool {
+				return MaxAllocsPerRun(t, 0, func() { performanceSink = make([]byte, 64) })
+			},
+			wantContains: []string{"Too many allocations: expected at most 0 allocation(s) per run, but got 1"},
+		},
+		{
+			name: "nil-function",
+			assertion: func(t T) bool {
+				return MaxAllocsPerRun(t, 0, nil)
+			},
+			wantContains: []string{"a non-nil function is required"},
+		},
+	})
+}
+
+func TestPerformanceMaxAllocsPerRunParallel(t *testing.T) {
+	t.Parallel()
+
+	mock := new(captureT)
+	if MaxAllocsPerRun(mock, 1, func() {}) {
+		t.Fatal("expected MaxAllocsPerRun to fail in a parallel test")
+	}
+
+	if !strings.Contains(mock.msg, "allocations cannot be measured while parallel tests are running") {
+		t.Errorf("unexpected failure message:\n%s", mock.msg)
+	}
+}
@@ -0,0 +1,102 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"fmt"
+
+	"github.com/go-openapi/testify/v2/internal/assertions/enable/proto"
+)
+
+// ProtoEqual asserts that two protobuf messages are equal, using the semantics of [proto.Equal].
+//
+// Unlike [Equal], the internal state of generated protobuf structs (e.g. caches, locks)
+// is not compared. Unknown fields are compared: see [ProtoEqualIgnoringUnknown] to ignore them.
+//
+// Expected and actual must be protobuf messages (i.e. implement [proto.Message]).
+//
+// # Important
+//
+// By default, this function is disabled and will panic.
+//
+// To enable it, you should add a blank import like so:
+//
+//	import(
+//	  _ "github.com/go-openapi/testify/enable/proto/v2"
+//	)
+//
+// # Usage
+//
+//	assertions.ProtoEqual(t, &pb.User{Name: "x"}, got)
+//
+// # Examples
+//
+//	panic: "a", "a"
+//	should panic without the proto feature enabled.
+//
+// [proto.Equal]: https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+// [proto.Message]: https://pkg.go.dev/google.golang.org/protobuf/proto#Message
+func ProtoEqual(t T, expected, actual any, msgAndArgs ...any) bool {
+	// Domain: proto
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	return protoEqual(t, expected, actual, false, msgAndArgs...)
+}
+
+// ProtoEqualIgnoringUnknown asserts that two protobuf messages are equal, using the semantics of [proto.Equal]
+// but ignoring unknown fields, at any nesting level.
+//
+// This is useful when comparing messages decoded from a peer using a more recent schema.
+//
+// See [ProtoEqual].
+//
+// # Usage
+//
+//	assertions.ProtoEqualIgnoringUnknown(t, &pb.User{Name: "x"}, got)
+//
+// # Examples
+//
+//	panic: "a", "a"
+//	should panic without the proto feature enabled.
+//
+// [proto.Equal]: https://pkg.go.dev/google.golang.org/protobuf/proto#Equal
+func ProtoEqualIgnoringUnknown(t T, expected, actual any, msgAndArgs ...any) bool {
+	// Domain: proto
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	return protoEqual(t, expected, actual, true, msgAndArgs...)
+}
+
+func protoEqual(t T, expected, actual any, ignoreUnknownFields bool, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	equal, err := proto.Equal(expected, actual, ignoreUnknownFields)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Invalid operation: %v", err), msgAndArgs...)
+	}
+
+	if equal {
+		return true
+	}
+
+	expectedText, err := proto.Format(expected)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Not equal, but could not format expected message: %v", err), msgAndArgs...)
+	}
+
+	actualText, err := proto.Format(actual)
+	if err != nil {
+		return Fail(t, fmt.Sprintf("Not equal, but could not format actual message: %v", err), msgAndArgs...)
+	}
+
+	return Fail(t, fmt.Sprintf("Not equal: \n"+
+		"expected: %s\n"+
+		"actual  : %s%s", expectedText, actualText, diff(expectedText, actualText)), msgAndArgs...)
+}
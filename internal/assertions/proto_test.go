@@ -0,0 +1,45 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"testing"
+)
+
+func TestProto(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should panic", testAllProtoEqual())
+}
+
+// =======================================
+// TestProto: all protobuf assertions
+// =======================================
+
+func testAllProtoEqual() func(*testing.T) {
+	return func(t *testing.T) {
+		t.Run("with ProtoEqual", testProtoEqual("ProtoEqual", ProtoEqual))
+		t.Run("with ProtoEqualIgnoringUnknown", testProtoEqual("ProtoEqualIgnoringUnknown", ProtoEqualIgnoringUnknown))
+	}
+}
+
+func testProtoEqual(name string, assertion func(T, any, any, ...any) bool) func(*testing.T) {
+	return func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		panicked := func() (didPanic bool) {
+			defer func() {
+				if recover() != nil {
+					didPanic = true
+				}
+			}()
+			_ = assertion(mock, "a", "a")
+			return false
+		}()
+		if !panicked {
+			croakWantPanic(t, name)
+		}
+	}
+}
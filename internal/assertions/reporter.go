@@ -0,0 +1,197 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"regexp"
+	"runtime"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Failure is a structured record of a failed assertion.
+//
+// Failures are passed to the [FailureReporter] hooks registered with [RegisterFailureReporter]
+// or [WithFailureReporter], e.g. to produce JSON reports or annotations for a CI system.
+//
+// Failures collected by a [CollectT] are not reported: only the failure of the enclosing assertion
+// (e.g. [EventuallyWith] or [Group]) is.
+type Failure struct {
+	// Assertion is the name of the failed assertion, e.g. "Equal" or "Equalf".
+	Assertion string
+
+	// Test is the name of the running test, if [T] supports Name().
+	Test string
+
+	// Message is the failure message produced by the assertion.
+	//
+	// This message is colorized whenever colors are enabled.
+	Message string
+
+	// Messages is the optional message provided by the caller of the assertion.
+	Messages string
+
+	// Expected and Actual are the values compared by the assertion, if any.
+	Expected any
+	Actual   any
+
+	// Diff is the unified diff between the expected and actual values, if any.
+	Diff string
+
+	// File and Line locate the failed assertion in the test code.
+	File string
+	Line int
+
+	// Trace is the stack of locations from the test to the failed assertion.
+	Trace []string
+}
+
+// FailureReporter is a hook called with every failed assertion.
+//
+// Reporters are called after the failure has been reported to [T]
+// and must be safe for concurrent use by parallel tests.
+type FailureReporter func(Failure)
+
+//nolint:gochecknoglobals // reporters may be registered for all tests, e.g. from TestMain
+var globalReporters struct {
+	mx        sync.RWMutex
+	reporters []*FailureReporter
+}
+
+// RegisterFailureReporter registers a hook called with every failed assertion, in all tests.
+//
+// This is typically called from TestMain. The returned function unregisters the hook.
+//
+// # Usage
+//
+//	func TestMain(m *testing.M) {
+//		unregister := assert.RegisterFailureReporter(func(f assert.Failure) {
+//			fmt.Fprintf(os.Stderr, "::error file=%s,line=%d,title=%s::%s\n", f.File, f.Line, f.Assertion, f.Message)
+//		})
+//		code := m.Run()
+//		unregister()
+//		os.Exit(code)
+//	}
+func RegisterFailureReporter(reporter FailureReporter) (unregister func()) {
+	registered := &reporter
+
+	globalReporters.mx.Lock()
+	globalReporters.reporters = append(globalReporters.reporters, registered)
+	globalReporters.mx.Unlock()
+
+	return func() {
+		globalReporters.mx.Lock()
+		defer globalReporters.mx.Unlock()
+
+		globalReporters.reporters = slices.DeleteFunc(globalReporters.reporters, func(r *FailureReporter) bool {
+			return r == registered
+		})
+	}
+}
+
+// WithFailureReporter configures a hook called with every failed assertion run with a given [T].
+//
+// The hook applies until the test completes. It is not inherited by subtests.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithFailureReporter(func(f assert.Failure) {
+//		failures = append(failures, f)
+//	}))
+func WithFailureReporter(reporter FailureReporter) Option {
+	return func(t T) {
+		configure(t, func(cfg *testConfig) {
+			cfg.reporters = append(cfg.reporters, reporter)
+		})
+	}
+}
+
+// reportFailure calls the global reporters, then the reporters configured for t.
+//
+// Failures collected by a [CollectT] are not final, and are not reported.
+func reportFailure(t T, failure Failure) {
+	if _, isCollector := t.(*CollectT); isCollector {
+		return
+	}
+
+	globalReporters.mx.RLock()
+	reporters := make([]FailureReporter, 0, len(globalReporters.reporters))
+	for _, reporter := range globalReporters.reporters {
+		reporters = append(reporters, *reporter)
+	}
+	globalReporters.mx.RUnlock()
+
+	if cfg, ok := configFor(t); ok {
+		reporters = append(reporters, cfg.reporters...)
+	}
+
+	if len(reporters) == 0 {
+		return
+	}
+
+	failure.Assertion = assertionName()
+	for _, reporter := range reporters {
+		reporter(failure)
+	}
+}
+
+// exported function or method, e.g. "Equal", "(*Assertions).Equal", "EqualT[...]".
+var rexAssertionName = regexp.MustCompile(`^(?:\(\*\w+\)\.)?([A-Z]\w*)(?:\[\.\.\.\])?$`)
+
+// assertionName returns the name of the outermost assertion in the current call stack,
+// i.e. the assertion called from the test code.
+func assertionName() string {
+	const stackFrameBufferSize = 32
+	pcs := make([]uintptr, stackFrameBufferSize)
+	n := runtime.Callers(1, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+
+	var name string
+	for {
+		frame, more := frames.Next()
+		if !isTestifyFile(frame.File) {
+			if name != "" {
+				break
+			}
+		} else if match := rexAssertionName.FindStringSubmatch(funcName(frame.Function)); match != nil {
+			name = match[1]
+		}
+
+		if !more {
+			break
+		}
+	}
+
+	return name
+}
+
+// isTestifyFile tells if a source file belongs to the assert, require, assertions or snapshot packages.
+//
+// Test files are excluded. This is consistent with the filtering applied by [CallerInfo].
+func isTestifyFile(file string) bool {
+	if strings.HasSuffix(file, "_test.go") {
+		return false
+	}
+
+	parts := strings.Split(file, "/")
+	if len(parts) < 2 { //nolint:mnd // need at least a directory and a file
+		return false
+	}
+
+	switch parts[len(parts)-2] {
+	case "assert", "require", "assertions", "snapshot":
+		return true
+	default:
+		return false
+	}
+}
+
+// funcName strips the package path from a fully qualified function name.
+func funcName(qualified string) string {
+	name := qualified[strings.LastIndexByte(qualified, '/')+1:]
+	_, name, _ = strings.Cut(name, ".")
+
+	return name
+}
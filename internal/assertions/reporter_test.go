@@ -0,0 +1,231 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package assertions
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestReporterWithFailureReporter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should report a failure with values", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(namedT)
+		var failures []Failure
+		WithFailureReporter(func(f Failure) {
+			failures = append(failures, f)
+		})(mock)
+
+		Equal(mock, reporterStruct{A: "a"}, reporterStruct{A: "b"}, "user message %d", 1)
+		file, line := failAt(mock)
+
+		if len(failures) != 2 {
+			t.Fatalf("expected two failures to be reported, got %d", len(failures))
+		}
+
+		f := failures[0]
+		if f.Assertion != "Equal" {
+			t.Errorf("expected assertion name to be Equal, got %q", f.Assertion)
+		}
+		if f.Test != mock.Name() {
+			t.Errorf("expected test name to be %q, got %q", mock.Name(), f.Test)
+		}
+		if !strings.HasPrefix(f.Message, "Not equal:") {
+			t.Errorf("unexpected failure message: %q", f.Message)
+		}
+		if f.Messages != "user message 1" {
+			t.Errorf("unexpected user message: %q", f.Messages)
+		}
+		if f.Expected != (reporterStruct{A: "a"}) || f.Actual != (reporterStruct{A: "b"}) {
+			t.Errorf("unexpected values: %#v, %#v", f.Expected, f.Actual)
+		}
+		if !strings.HasPrefix(f.Diff, "--- Expected\n+++ Actual\n") {
+			t.Errorf("unexpected diff: %q", f.Diff)
+		}
+		// NOTE: frames from this package are not part of the trace, except from mock_test.go
+		if f.File != "" || f.Line != 0 || len(f.Trace) != 0 {
+			t.Errorf("expected no call site, got %s:%d", f.File, f.Line)
+		}
+
+		f = failures[1]
+		if f.File != file || f.Line != line {
+			t.Errorf("expected the call site to be %s:%d, got %s:%d", file, line, f.File, f.Line)
+		}
+		if len(f.Trace) == 0 || f.Trace[0] != fmt.Sprintf("%s:%d", file, line) {
+			t.Errorf("expected the trace to start with the call site, got %v", f.Trace)
+		}
+	})
+
+	t.Run("should report a failure without values", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(namedT)
+		var failures []Failure
+		WithFailureReporter(func(f Failure) {
+			failures = append(failures, f)
+		})(mock)
+
+		True(mock, false)
+
+		if len(failures) != 1 {
+			t.Fatalf("expected one failure to be reported, got %d", len(failures))
+		}
+
+		f := failures[0]
+		if f.Assertion != "True" {
+			t.Errorf("expected assertion name to be True, got %q", f.Assertion)
+		}
+		if f.Expected != nil || f.Actual != nil || f.Diff != "" {
+			t.Errorf("expected no values, got %#v", f)
+		}
+	})
+
+	t.Run("should not report successes", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(namedT)
+		WithFailureReporter(func(f Failure) {
+			t.Errorf("unexpected failure reported: %#v", f)
+		})(mock)
+
+		Equal(mock, 1, 1)
+	})
+
+	t.Run("should not report failures of another T", func(t *testing.T) {
+		t.Parallel()
+
+		WithFailureReporter(func(f Failure) {
+			t.Errorf("unexpected failure reported: %#v", f)
+		})(new(namedT))
+
+		Equal(new(namedT), 1, 2)
+	})
+}
+
+func TestReporterRegisterFailureReporter(t *testing.T) {
+	t.Parallel()
+
+	mock := &namedT{name: t.Name()}
+	var (
+		mx       sync.Mutex
+		failures []Failure
+	)
+	unregister := RegisterFailureReporter(func(f Failure) {
+		if f.Test != mock.Name() { // other tests may run in parallel
+			return
+		}
+
+		mx.Lock()
+		failures = append(failures, f)
+		mx.Unlock()
+	})
+
+	Fail(mock, "failed")
+	unregister()
+	Fail(mock, "failed again")
+
+	mx.Lock()
+	defer mx.Unlock()
+
+	if len(failures) != 1 {
+		t.Fatalf("expected one failure to be reported, got %d", len(failures))
+	}
+	if failures[0].Assertion != "Fail" || failures[0].Message != "failed" {
+		t.Errorf("unexpected failure: %#v", failures[0])
+	}
+}
+
+// Global reporters receive failures from all tests: this test must not run in parallel.
+//
+//nolint:paralleltest // see above
+func TestReporterCollectedFailures(t *testing.T) {
+	var failures []Failure
+	unregister := RegisterFailureReporter(func(f Failure) {
+		failures = append(failures, f)
+	})
+	defer unregister()
+
+	t.Run("should not report failures collected by EventuallyWith", func(t *testing.T) {
+		failures = nil
+
+		var ticks int
+		if !EventuallyWith(new(namedT), func(c *CollectT) {
+			ticks++
+			True(c, ticks > 2)
+		}, time.Second, time.Millisecond) {
+			t.Fatal("expected EventuallyWith to succeed")
+		}
+
+		if len(failures) != 0 {
+			t.Errorf("expected no failure to be reported, got %#v", failures)
+		}
+	})
+
+	t.Run("should report a failed group once", func(t *testing.T) {
+		failures = nil
+
+		Group(new(namedT), func(c *CollectT) {
+			True(c, false)
+			Equal(c, 1, 2)
+		})
+
+		if len(failures) != 1 {
+			t.Fatalf("expected one failure to be reported, got %d", len(failures))
+		}
+		if failures[0].Assertion != "Group" {
+			t.Errorf("expected assertion name to be Group, got %q", failures[0].Assertion)
+		}
+	})
+}
+
+func TestReporterFuncName(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		qualified string
+		want      string
+		isMatch   bool
+	}{
+		{qualified: "github.com/go-openapi/testify/v2/assert.Equal", want: "Equal", isMatch: true},
+		{qualified: "github.com/go-openapi/testify/v2/assert.(*Assertions).Equalf", want: "Equalf", isMatch: true},
+		{qualified: "github.com/go-openapi/testify/v2/internal/assertions.EqualT[...]", want: "EqualT", isMatch: true},
+		{qualified: "github.com/go-openapi/testify/v2/internal/assertions.failWithDiff", isMatch: false},
+		{qualified: "github.com/go-openapi/testify/v2/assert.TestX.func1", isMatch: false},
+	} {
+		match := rexAssertionName.FindStringSubmatch(funcName(tc.qualified))
+		if (match != nil) != tc.isMatch {
+			t.Errorf("%s: expected match to be %t", tc.qualified, tc.isMatch)
+
+			continue
+		}
+
+		if match != nil && match[1] != tc.want {
+			t.Errorf("%s: expected %q, got %q", tc.qualified, tc.want, match[1])
+		}
+	}
+}
+
+type namedT struct {
+	mockT
+
+	name string
+}
+
+func (m *namedT) Name() string {
+	if m.name == "" {
+		return "TestNamed"
+	}
+
+	return m.name
+}
+
+type reporterStruct struct {
+	A string
+}
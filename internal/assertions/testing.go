@@ -4,8 +4,10 @@
 package assertions
 
 import (
+	"errors"
 	"fmt"
 	"runtime"
+	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
@@ -26,11 +28,7 @@ func Fail(t T, failureMessage string, msgAndArgs ...any) bool {
 		h.Helper()
 	}
 
-	if failureMessage != "" || len(msgAndArgs) > 0 {
-		errorWithCallerInfo(t, 1, failureMessage, msgAndArgs...)
-	}
-
-	return false
+	return failWith(t, failureMessage, Failure{}, msgAndArgs...)
 }
 
 // FailNow fails test.
@@ -64,6 +62,114 @@ func FailNow(t T, failureMessage string, msgAndArgs ...any) bool {
 	return false
 }
 
+// Group runs a block of assertions and reports all their failures together, at the end of the block.
+//
+// This gives "soft assertion" semantics to long validations, e.g. when checking all the fields of a large
+// table: every failed assertion in the block is reported, not just the first one.
+//
+// The block is supplied with a [CollectT], which collects the failures of the assertions called with it.
+// Calling [CollectT.FailNow] (directly, or transitively through [require] assertions) or [CollectT.Cancel]
+// stops the block. The failures collected so far are reported.
+// A stopped block fails the group, even when no assertion failed: e.g. [CollectT.Cancel] reports "group cancelled".
+//
+// Forward methods may be used in the block by wrapping the [CollectT], e.g. with assert.New(c).
+//
+// The options configured for t, e.g. with [WithComparers], apply to the assertions in the block.
+//
+// # Usage
+//
+//	assertions.Group(t, func(c *assertions.CollectT) {
+//		for _, row := range rows {
+//			assertions.Equal(c, row.Expected, row.Actual, "row %d", row.ID)
+//		}
+//	})
+//
+// # Examples
+//
+//	success: func(c *CollectT) { True(c, true); Equal(c, 1, 1) }
+//	failure: func(c *CollectT) { True(c, false); Equal(c, 1, 2) }
+func Group(t T, block func(*CollectT), msgAndArgs ...any) bool {
+	// Domain: testing
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if block == nil {
+		return Fail(t, "a non-nil block is required", msgAndArgs...)
+	}
+
+	collector := new(CollectT).
+		withCancelFunc(func() {}).
+		withExitFunc(func() { panic(errGroupAborted) }).
+		withConfigOf(t)
+
+	aborted := runGroup(block, collector)
+
+	var cancelled bool
+	failures := make([]string, 0, len(collector.errors))
+	for _, err := range collector.errors {
+		if errors.Is(err, errCancelled) {
+			cancelled = true
+
+			continue
+		}
+		if errors.Is(err, errFailNow) {
+			continue
+		}
+
+		failures = append(failures, strings.TrimRight(err.Error(), "\n"))
+	}
+
+	switch {
+	case len(failures) == 0 && !aborted:
+		return true
+	case len(failures) == 0 && cancelled:
+		return Fail(t, "group cancelled before completion", msgAndArgs...)
+	case len(failures) == 0:
+		return Fail(t, "group aborted before completion", msgAndArgs...)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "%d assertion(s) failed in group", len(failures))
+	switch {
+	case cancelled:
+		msg.WriteString(" (the group was cancelled before completion)")
+	case aborted:
+		msg.WriteString(" (the group was aborted before completion)")
+	}
+	msg.WriteByte(':')
+	for i, failure := range failures {
+		fmt.Fprintf(&msg, "\n\n[%d/%d]%s", i+1, len(failures), failure)
+	}
+
+	return Fail(t, msg.String(), msgAndArgs...)
+}
+
+// errGroupAborted is used to stop a [Group] block on [CollectT.FailNow] or [CollectT.Cancel].
+//
+// Unlike [EventuallyWith], the block runs on the goroutine of the test, which must not exit.
+var errGroupAborted = errors.New("group aborted") //nolint:gochecknoglobals // sentinel error
+
+// runGroup runs the block on the calling goroutine, so failures are reported with the call stack of the test.
+//
+// It tells if the block has been aborted. Other panics are propagated.
+func runGroup(block func(*CollectT), collector *CollectT) (aborted bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			err, isError := r.(error)
+			if !isError || !errors.Is(err, errGroupAborted) {
+				panic(r)
+			}
+
+			aborted = true
+		}
+	}()
+
+	block(collector)
+
+	return false
+}
+
 // CallerInfo returns an array of strings containing the file and line number
 // of each stack frame leading from the current test to the assert call that
 // failed.
@@ -91,19 +197,35 @@ func isTest(name, prefix string) bool {
 	return !unicode.IsLower(r)
 }
 
-func errorWithCallerInfo(t T, offset int, failureMessage string, msgAndArgs ...any) {
+// failWith is like [Fail], with the details of the failure reported to [FailureReporter] hooks
+// (e.g. the expected and actual values).
+func failWith(t T, failureMessage string, details Failure, msgAndArgs ...any) bool {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+
+	if failureMessage != "" || len(msgAndArgs) > 0 {
+		errorWithCallerInfo(t, 1, failureMessage, details, msgAndArgs...)
+	}
+
+	return false
+}
+
+func errorWithCallerInfo(t T, offset int, failureMessage string, details Failure, msgAndArgs ...any) {
 	if h, ok := t.(H); ok {
 		h.Helper()
 	}
 
+	trace := callerInfo(offset)
 	content := []labeledContent{
-		{"Error Trace", strings.Join(callerInfo(offset), "\n\t\t\t")},
+		{"Error Trace", strings.Join(trace, "\n\t\t\t")},
 		{"Error", failureMessage},
 	}
 
 	// Add test name if the Go version supports it
 	if n, ok := t.(namer); ok {
-		content = append(content, labeledContent{"Test", n.Name()})
+		details.Test = n.Name()
+		content = append(content, labeledContent{"Test", details.Test})
 	}
 
 	message := messageFromMsgAndArgs(msgAndArgs...)
@@ -112,6 +234,26 @@ func errorWithCallerInfo(t T, offset int, failureMessage string, msgAndArgs ...a
 	}
 
 	t.Errorf("\n%s", ""+labeledOutput(content...))
+
+	details.Message = failureMessage
+	details.Messages = message
+	details.Trace = trace
+	if len(trace) > 0 {
+		details.File, details.Line = splitCallSite(trace[0])
+	}
+	reportFailure(t, details)
+}
+
+// splitCallSite splits a "file:line" location from [callerInfo].
+func splitCallSite(callSite string) (file string, line int) {
+	pos := strings.LastIndexByte(callSite, ':')
+	if pos < 0 {
+		return callSite, 0
+	}
+
+	line, _ = strconv.Atoi(callSite[pos+1:])
+
+	return callSite[:pos], line
 }
 
 func callerInfo(offset int) []string {
@@ -164,7 +306,8 @@ func callerInfo(offset int) []string {
 			if len(parts) > 1 {
 				filename := parts[len(parts)-1]
 				dir := parts[len(parts)-2]
-				if (dir != "assert" && dir != "mock" && dir != "require" && dir != "assertions") || filename == "mock_test.go" {
+				if (dir != "assert" && dir != "mock" && dir != "require" && dir != "assertions" && dir != "snapshot") ||
+					filename == "mock_test.go" || (dir == "snapshot" && strings.HasSuffix(filename, "_test.go")) {
 					callers = append(callers, fmt.Sprintf("%s:%d", file, line))
 				}
 			}
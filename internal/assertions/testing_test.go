@@ -4,6 +4,8 @@
 package assertions
 
 import (
+	"iter"
+	"slices"
 	"testing"
 )
 
@@ -49,3 +51,187 @@ func TestTestingFailNow(t *testing.T) {
 		})
 	})
 }
+
+func TestTestingGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should pass when all assertions pass", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		res := Group(mock, func(c *CollectT) {
+			True(c, true)
+			Equal(c, 1, 1)
+		})
+		shouldPassOrFail(t, mock, res, true)
+	})
+
+	t.Run("should run all assertions", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		var ran int
+		res := Group(mock, func(c *CollectT) {
+			for i := range 3 {
+				ran++
+				Equal(c, i, -1)
+			}
+		})
+		shouldPassOrFail(t, mock, res, false)
+
+		if ran != 3 {
+			t.Errorf("expected all assertions to run, but only %d did", ran)
+		}
+	})
+
+	t.Run("should stop on FailNow", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		var ran bool
+		res := Group(mock, func(c *CollectT) {
+			FailNow(c, "stop")
+			ran = true
+		})
+		shouldPassOrFail(t, mock, res, false)
+
+		if ran {
+			t.Error("expected the block to stop after FailNow")
+		}
+	})
+
+	t.Run("should stop on Cancel", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		res := Group(mock, func(c *CollectT) {
+			c.Cancel()
+		})
+		shouldPassOrFail(t, mock, res, false)
+	})
+
+	t.Run("should propagate panics", func(t *testing.T) {
+		t.Parallel()
+
+		defer func() {
+			if r := recover(); r != "boom" {
+				t.Errorf("expected the panic to be propagated, got %v", r)
+			}
+		}()
+
+		Group(new(mockT), func(*CollectT) {
+			panic("boom")
+		})
+	})
+
+	t.Run("should fail with a nil block", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		res := Group(mock, nil)
+		shouldPassOrFail(t, mock, res, false)
+	})
+
+	t.Run("should apply the options of t", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockT)
+		var reported int
+		WithComparers(CompareWith(func(_, _ int) bool { return true }))(mock)
+		WithFailureReporter(func(Failure) { reported++ })(mock)
+
+		res := Group(mock, func(c *CollectT) {
+			Equal(c, 1, 2)
+		})
+		shouldPassOrFail(t, mock, res, true)
+
+		res = Group(mock, func(c *CollectT) {
+			True(c, false)
+		})
+		shouldPassOrFail(t, mock, res, false)
+
+		if reported != 1 {
+			t.Errorf("expected the failed group to be reported once, got %d", reported)
+		}
+	})
+}
+
+func TestTestingGroupErrorMessages(t *testing.T) {
+	t.Parallel()
+
+	runFailCases(t, groupFailCases())
+}
+
+func groupFailCases() iter.Seq[failCase] {
+	return slices.Values([]failCase{
+		{
+			name: "all-failures-reported",
+			assertion: func(t T) bool {
+				return Group(t, func(c *CollectT) {
+					Equal(c, 1, 2)
+					True(c, false, "second check")
+				})
+			},
+			wantContains: []string{
+				"2 assertion(s) failed in group:",
+				"[1/2]",
+				"Not equal:",
+				"[2/2]",
+				"Should be true",
+				"second check",
+			},
+		},
+		{
+			name: "aborted",
+			assertion: func(t T) bool {
+				return Group(t, func(c *CollectT) {
+					Equal(c, 1, 2)
+					FailNow(c, "stop here")
+					True(c, false)
+				})
+			},
+			wantContains: []string{
+				"2 assertion(s) failed in group (the group was aborted before completion):",
+				"stop here",
+			},
+		},
+		{
+			name: "cancelled",
+			assertion: func(t T) bool {
+				return Group(t, func(c *CollectT) {
+					c.Cancel()
+				})
+			},
+			wantError: "group cancelled before completion",
+		},
+		{
+			name: "cancelled-with-failures",
+			assertion: func(t T) bool {
+				return Group(t, func(c *CollectT) {
+					Equal(c, 1, 2)
+					c.Cancelf("giving up")
+				})
+			},
+			wantContains: []string{
+				"2 assertion(s) failed in group (the group was cancelled before completion):",
+				"giving up",
+			},
+		},
+		{
+			name: "failed-now-without-failure",
+			assertion: func(t T) bool {
+				return Group(t, func(c *CollectT) {
+					c.FailNow()
+				})
+			},
+			wantError: "group aborted before completion",
+		},
+		{
+			name: "nil-block",
+			assertion: func(t T) bool {
+				return Group(t, nil)
+			},
+			wantContains: []string{"a non-nil block is required"},
+		},
+	})
+}
@@ -21,7 +21,7 @@ import (
 // To enable it, you should add a blank import like so:
 //
 //	import(
-//	  "github.com/go-openapi/testify/enable/yaml/v2"
+//	  _ "github.com/go-openapi/testify/enable/yaml/v2"
 //	)
 //
 // For dynamic redaction of the input text via a callback, use [YAMLEqT].
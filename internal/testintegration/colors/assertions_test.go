@@ -33,6 +33,25 @@ func TestColorsAssertJSONEq(t *testing.T) {
 	target.Contains(t, output, "\x1b")
 }
 
+func TestColorsAssertEqualValues(t *testing.T) {
+	t.Parallel()
+
+	type record struct {
+		Name  string
+		Count int
+	}
+
+	mockT := new(mockT)
+	res := target.EqualValues(mockT, record{Name: "a", Count: 1}, record{Name: "a", Count: 2})
+
+	target.False(t, res)
+
+	output := mockT.errorString()
+	t.Log(output) // best to visualize the output
+	target.Contains(t, output, "\x1b")
+	target.Contains(t, output, "Diff:")
+}
+
 type mockT struct {
 	errorFmt string
 	args     []any
@@ -6,10 +6,13 @@
 package require
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"slices"
@@ -63,6 +66,29 @@ func TestBlockedT(t *testing.T) {
 	})
 }
 
+func TestCompletesWithin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		CompletesWithin(mock, time.Second, func() {})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		CompletesWithin(mock, 10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("CompletesWithin should call FailNow()")
+		}
+	})
+}
+
 func TestCondition(t *testing.T) {
 	t.Parallel()
 
@@ -132,6 +158,29 @@ func TestContains(t *testing.T) {
 	})
 }
 
+func TestDirEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		DirEqual(mock, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		DirEqual(mock, testDataPath(), filepath.Join(testDataPath(), "existing_dir"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("DirEqual should call FailNow()")
+		}
+	})
+}
+
 func TestDirExists(t *testing.T) {
 	t.Parallel()
 
@@ -316,6 +365,29 @@ func TestEqualExportedValues(t *testing.T) {
 	})
 }
 
+func TestEqualIgnoringFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EqualIgnoringFields(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EqualIgnoringFields(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"})
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("EqualIgnoringFields should call FailNow()")
+		}
+	})
+}
+
 func TestEqualT(t *testing.T) {
 	t.Parallel()
 
@@ -408,6 +480,29 @@ func TestErrorAs(t *testing.T) {
 	})
 }
 
+func TestErrorAsType(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		ErrorAsType[*dummyError](mock, fmt.Errorf("wrap: %w", &dummyError{}))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		ErrorAsType[*dummyError](mock, ErrTest)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("ErrorAsType should call FailNow()")
+		}
+	})
+}
+
 func TestErrorContains(t *testing.T) {
 	t.Parallel()
 
@@ -511,6 +606,29 @@ func TestEventuallyWith(t *testing.T) {
 	})
 }
 
+func TestEventuallyWithContext(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EventuallyWithContext(mock, context.Background(), func() bool { return true }, 20*time.Millisecond)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EventuallyWithContext(mock, func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("EventuallyWithContext should call FailNow()")
+		}
+	})
+}
+
 func TestExactly(t *testing.T) {
 	t.Parallel()
 
@@ -534,6 +652,29 @@ func TestExactly(t *testing.T) {
 	})
 }
 
+func TestFSEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FSEqual(mock, os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FSEqual(mock, os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FSEqual should call FailNow()")
+		}
+	})
+}
+
 func TestFail(t *testing.T) {
 	t.Parallel()
 
@@ -633,6 +774,52 @@ func TestFileEmpty(t *testing.T) {
 	})
 }
 
+func TestFileEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqual(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqual(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FileEqual should call FailNow()")
+		}
+	})
+}
+
+func TestFileEqualBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualBytes(mock, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualBytes(mock, []byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FileEqualBytes should call FailNow()")
+		}
+	})
+}
+
 func TestFileExists(t *testing.T) {
 	t.Parallel()
 
@@ -794,6 +981,29 @@ func TestGreaterT(t *testing.T) {
 	})
 }
 
+func TestGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Group(mock, func(c *CollectT) { True(c, true); Equal(c, 1, 1) })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Group(mock, func(c *CollectT) { True(c, false); Equal(c, 1, 2) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Group should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPBodyContains(t *testing.T) {
 	t.Parallel()
 
@@ -817,6 +1027,29 @@ func TestHTTPBodyContains(t *testing.T) {
 	})
 }
 
+func TestHTTPBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPBodyJSONEq(mock, httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPBodyJSONEq(mock, httpJSON, "GET", "/", nil, `{"hello": "bob"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPBodyJSONEq should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPBodyNotContains(t *testing.T) {
 	t.Parallel()
 
@@ -863,6 +1096,29 @@ func TestHTTPError(t *testing.T) {
 	})
 }
 
+func TestHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPHeader(mock, httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPHeader(mock, httpJSON, "GET", "/", nil, "Content-Type", "text/plain")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPHeader should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPRedirect(t *testing.T) {
 	t.Parallel()
 
@@ -886,6 +1142,52 @@ func TestHTTPRedirect(t *testing.T) {
 	})
 }
 
+func TestHTTPRoundTripBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripBodyJSONEq(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripBodyJSONEq(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPRoundTripBodyJSONEq should call FailNow()")
+		}
+	})
+}
+
+func TestHTTPRoundTripStatusCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripStatusCode(mock, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripStatusCode(mock, httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPRoundTripStatusCode should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPStatusCode(t *testing.T) {
 	t.Parallel()
 
@@ -1530,6 +1832,52 @@ func TestJSONMarshalAsT(t *testing.T) {
 	})
 }
 
+func TestJSONMatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatches(mock, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatches(mock, `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("JSONMatches should call FailNow()")
+		}
+	})
+}
+
+func TestJSONMatchesT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesT(mock, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesT(mock, `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("JSONMatchesT should call FailNow()")
+		}
+	})
+}
+
 func TestJSONUnmarshalAsT(t *testing.T) {
 	t.Parallel()
 
@@ -1737,6 +2085,29 @@ func TestMapEqualT(t *testing.T) {
 	})
 }
 
+func TestMapLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		MapLenT(mock, map[string]string{"A": "B"}, 1)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		MapLenT(mock, map[string]string{"A": "B"}, 2)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("MapLenT should call FailNow()")
+		}
+	})
+}
+
 func TestMapNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -1783,6 +2154,23 @@ func TestMapNotEqualT(t *testing.T) {
 	})
 }
 
+func TestMaxAllocsPerRun(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		MaxAllocsPerRun(mock, 0, func() {})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		MaxAllocsPerRun(mock, 0, func() { _ = make([]byte, 1<<20) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("MaxAllocsPerRun should call FailNow()")
+		}
+	})
+}
+
 func TestNegative(t *testing.T) {
 	t.Parallel()
 
@@ -2451,6 +2839,29 @@ func TestPanics(t *testing.T) {
 	})
 }
 
+func TestPanicsMatching(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsMatching(mock, func(v any) bool { return v == "panicking" }, func() { panic("panicking") })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsMatching(mock, func(v any) bool { return v == "panicking" }, func() { panic("other") })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("PanicsMatching should call FailNow()")
+		}
+	})
+}
+
 func TestPanicsWithError(t *testing.T) {
 	t.Parallel()
 
@@ -2474,6 +2885,29 @@ func TestPanicsWithError(t *testing.T) {
 	})
 }
 
+func TestPanicsWithErrorIs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsWithErrorIs(mock, ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsWithErrorIs(mock, ErrTest, func() {})
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("PanicsWithErrorIs should call FailNow()")
+		}
+	})
+}
+
 func TestPanicsWithValue(t *testing.T) {
 	t.Parallel()
 
@@ -2543,6 +2977,38 @@ func TestPositiveT(t *testing.T) {
 	})
 }
 
+func TestProtoEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Panics(t, func() {
+			ProtoEqual(mock, "a", "a")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("ProtoEqual should panic as expected")
+		}
+	})
+}
+
+func TestProtoEqualIgnoringUnknown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Panics(t, func() {
+			ProtoEqualIgnoringUnknown(mock, "a", "a")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("ProtoEqualIgnoringUnknown should panic as expected")
+		}
+	})
+}
+
 func TestRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -2658,6 +3124,29 @@ func TestSeqContainsT(t *testing.T) {
 	})
 }
 
+func TestSeqLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SeqLenT(mock, slices.Values([]string{"A", "B"}), 2)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SeqLenT(mock, slices.Values([]string{"A", "B"}), 1)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("SeqLenT should call FailNow()")
+		}
+	})
+}
+
 func TestSeqNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -2727,6 +3216,29 @@ func TestSliceEqualT(t *testing.T) {
 	})
 }
 
+func TestSliceLenT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SliceLenT(mock, []string{"A", "B"}, 2)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SliceLenT(mock, []string{"A", "B"}, 1)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("SliceLenT should call FailNow()")
+		}
+	})
+}
+
 func TestSliceNotContainsT(t *testing.T) {
 	t.Parallel()
 
@@ -3154,6 +3666,21 @@ func httpBody(w http.ResponseWriter, r *http.Request) {
 	_, _ = fmt.Fprintf(w, "Hello, %s!", name)
 }
 
+func httpJSON(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"hello": "world"}`)
+}
+
+// httpRoundTripper serves requests with a handler, in place of a client transport.
+type httpRoundTripper http.HandlerFunc
+
+func (f httpRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	f(w, r)
+
+	return w.Result(), nil
+}
+
 func sendChanMessage() chan struct{} {
 	ch := make(chan struct{}, 1)
 	ch <- struct{}{}
@@ -6,10 +6,13 @@
 package require_test
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -37,6 +40,15 @@ func ExampleBlockedT() {
 	// Output: passed
 }
 
+func ExampleCompletesWithin() {
+	t := new(testing.T) // should come from testing, e.g. func TestCompletesWithin(t *testing.T)
+	require.CompletesWithin(t, time.Second, func() {
+	})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleCondition() {
 	t := new(testing.T) // should come from testing, e.g. func TestCondition(t *testing.T)
 	require.Condition(t, func() bool {
@@ -65,6 +77,14 @@ func ExampleContains() {
 	// Output: passed
 }
 
+func ExampleDirEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestDirEqual(t *testing.T)
+	require.DirEqual(t, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleDirExists() {
 	t := new(testing.T) // should come from testing, e.g. func TestDirExists(t *testing.T)
 	require.DirExists(t, filepath.Join(testDataPath(), "existing_dir"))
@@ -129,6 +149,14 @@ func ExampleEqualExportedValues() {
 	// Output: passed
 }
 
+func ExampleEqualIgnoringFields() {
+	t := new(testing.T) // should come from testing, e.g. func TestEqualIgnoringFields(t *testing.T)
+	require.EqualIgnoringFields(t, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleEqualT() {
 	t := new(testing.T) // should come from testing, e.g. func TestEqualT(t *testing.T)
 	require.EqualT(t, 123, 123)
@@ -161,6 +189,14 @@ func ExampleErrorAs() {
 	// Output: passed
 }
 
+func ExampleErrorAsType() {
+	t := new(testing.T) // should come from testing, e.g. func TestErrorAsType(t *testing.T)
+	require.ErrorAsType[*dummyError](t, fmt.Errorf("wrap: %w", &dummyError{}))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleErrorContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestErrorContains(t *testing.T)
 	require.ErrorContains(t, require.ErrTest, "general error")
@@ -197,6 +233,16 @@ func ExampleEventuallyWith() {
 	// Output: passed
 }
 
+func ExampleEventuallyWithContext() {
+	t := new(testing.T) // should come from testing, e.g. func TestEventuallyWithContext(t *testing.T)
+	require.EventuallyWithContext(t, context.Background(), func() bool {
+		return true
+	}, 20*time.Millisecond)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleExactly() {
 	t := new(testing.T) // should come from testing, e.g. func TestExactly(t *testing.T)
 	require.Exactly(t, int32(123), int32(123))
@@ -205,6 +251,14 @@ func ExampleExactly() {
 	// Output: passed
 }
 
+func ExampleFSEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestFSEqual(t *testing.T)
+	require.FSEqual(t, os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 // func ExampleFail() {
 // no success example available. Please add some examples to produce a testable example.
 // }
@@ -237,6 +291,22 @@ func ExampleFileEmpty() {
 	// Output: passed
 }
 
+func ExampleFileEqual() {
+	t := new(testing.T) // should come from testing, e.g. func TestFileEqual(t *testing.T)
+	require.FileEqual(t, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
+func ExampleFileEqualBytes() {
+	t := new(testing.T) // should come from testing, e.g. func TestFileEqualBytes(t *testing.T)
+	require.FileEqualBytes(t, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleFileExists() {
 	t := new(testing.T) // should come from testing, e.g. func TestFileExists(t *testing.T)
 	require.FileExists(t, filepath.Join(testDataPath(), "existing_file"))
@@ -293,6 +363,17 @@ func ExampleGreaterT() {
 	// Output: passed
 }
 
+func ExampleGroup() {
+	t := new(testing.T) // should come from testing, e.g. func TestGroup(t *testing.T)
+	require.Group(t, func(c *assert.CollectT) {
+		assert.True(c, true)
+		assert.Equal(c, 1, 1)
+	})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleHTTPBodyContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyContains(t *testing.T)
 	require.HTTPBodyContains(t, httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, World!")
@@ -301,6 +382,14 @@ func ExampleHTTPBodyContains() {
 	// Output: passed
 }
 
+func ExampleHTTPBodyJSONEq() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyJSONEq(t *testing.T)
+	require.HTTPBodyJSONEq(t, httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleHTTPBodyNotContains() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPBodyNotContains(t *testing.T)
 	require.HTTPBodyNotContains(t, httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, Bob!")
@@ -317,6 +406,14 @@ func ExampleHTTPError() {
 	// Output: passed
 }
 
+func ExampleHTTPHeader() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPHeader(t *testing.T)
+	require.HTTPHeader(t, httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleHTTPRedirect() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPRedirect(t *testing.T)
 	require.HTTPRedirect(t, httpRedirect, "GET", "/", nil)
@@ -325,6 +422,22 @@ func ExampleHTTPRedirect() {
 	// Output: passed
 }
 
+func ExampleHTTPRoundTripBodyJSONEq() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPRoundTripBodyJSONEq(t *testing.T)
+	require.HTTPRoundTripBodyJSONEq(t, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
+func ExampleHTTPRoundTripStatusCode() {
+	t := new(testing.T) // should come from testing, e.g. func TestHTTPRoundTripStatusCode(t *testing.T)
+	require.HTTPRoundTripStatusCode(t, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleHTTPStatusCode() {
 	t := new(testing.T) // should come from testing, e.g. func TestHTTPStatusCode(t *testing.T)
 	require.HTTPStatusCode(t, httpOK, "GET", "/", nil, http.StatusOK)
@@ -549,6 +662,22 @@ func ExampleJSONMarshalAsT() {
 	// Output: passed
 }
 
+func ExampleJSONMatches() {
+	t := new(testing.T) // should come from testing, e.g. func TestJSONMatches(t *testing.T)
+	require.JSONMatches(t, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
+func ExampleJSONMatchesT() {
+	t := new(testing.T) // should come from testing, e.g. func TestJSONMatchesT(t *testing.T)
+	require.JSONMatchesT(t, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`))
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleJSONUnmarshalAsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestJSONUnmarshalAsT(t *testing.T)
 	require.JSONUnmarshalAsT(t, dummyStruct{A: "a"}, []byte(`{"A": "a"}`))
@@ -621,6 +750,14 @@ func ExampleMapEqualT() {
 	// Output: passed
 }
 
+func ExampleMapLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestMapLenT(t *testing.T)
+	require.MapLenT(t, map[string]string{"A": "B"}, 1)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleMapNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestMapNotContainsT(t *testing.T)
 	require.MapNotContainsT(t, map[string]string{"A": "B"}, "C")
@@ -637,6 +774,15 @@ func ExampleMapNotEqualT() {
 	// Output: passed
 }
 
+func ExampleMaxAllocsPerRun() {
+	t := new(testing.T) // should come from testing, e.g. func TestMaxAllocsPerRun(t *testing.T)
+	require.MaxAllocsPerRun(t, 0, func() {
+	})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleNegative() {
 	t := new(testing.T) // should come from testing, e.g. func TestNegative(t *testing.T)
 	require.Negative(t, -1)
@@ -892,6 +1038,18 @@ func ExamplePanics() {
 	// Output: passed
 }
 
+func ExamplePanicsMatching() {
+	t := new(testing.T) // should come from testing, e.g. func TestPanicsMatching(t *testing.T)
+	require.PanicsMatching(t, func(v any) bool {
+		return v == "panicking"
+	}, func() {
+		panic("panicking")
+	})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExamplePanicsWithError() {
 	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithError(t *testing.T)
 	require.PanicsWithError(t, assert.ErrTest.Error(), func() {
@@ -902,6 +1060,16 @@ func ExamplePanicsWithError() {
 	// Output: passed
 }
 
+func ExamplePanicsWithErrorIs() {
+	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithErrorIs(t *testing.T)
+	require.PanicsWithErrorIs(t, require.ErrTest, func() {
+		panic(fmt.Errorf("wrap: %w", assert.ErrTest))
+	})
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExamplePanicsWithValue() {
 	t := new(testing.T) // should come from testing, e.g. func TestPanicsWithValue(t *testing.T)
 	require.PanicsWithValue(t, "panicking", func() {
@@ -928,6 +1096,14 @@ func ExamplePositiveT() {
 	// Output: passed
 }
 
+// func ExampleProtoEqual() {
+// no success example available. Please add some examples to produce a testable example.
+// }
+
+// func ExampleProtoEqualIgnoringUnknown() {
+// no success example available. Please add some examples to produce a testable example.
+// }
+
 func ExampleRegexp() {
 	t := new(testing.T) // should come from testing, e.g. func TestRegexp(t *testing.T)
 	require.Regexp(t, "^start", "starting")
@@ -968,6 +1144,14 @@ func ExampleSeqContainsT() {
 	// Output: passed
 }
 
+func ExampleSeqLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestSeqLenT(t *testing.T)
+	require.SeqLenT(t, slices.Values([]string{"A", "B"}), 2)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleSeqNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestSeqNotContainsT(t *testing.T)
 	require.SeqNotContainsT(t, slices.Values([]string{"A", "B"}), "C")
@@ -992,6 +1176,14 @@ func ExampleSliceEqualT() {
 	// Output: passed
 }
 
+func ExampleSliceLenT() {
+	t := new(testing.T) // should come from testing, e.g. func TestSliceLenT(t *testing.T)
+	require.SliceLenT(t, []string{"A", "B"}, 2)
+	fmt.Println("passed")
+
+	// Output: passed
+}
+
 func ExampleSliceNotContainsT() {
 	t := new(testing.T) // should come from testing, e.g. func TestSliceNotContainsT(t *testing.T)
 	require.SliceNotContainsT(t, []string{"A", "B"}, "C")
@@ -1141,6 +1333,21 @@ func httpBody(w http.ResponseWriter, r *http.Request) {
 	_, _ = fmt.Fprintf(w, "Hello, %s!", name)
 }
 
+func httpJSON(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = fmt.Fprint(w, `{"hello": "world"}`)
+}
+
+// httpRoundTripper serves requests with a handler, in place of a client transport.
+type httpRoundTripper http.HandlerFunc
+
+func (f httpRoundTripper) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	f(w, r)
+
+	return w.Result(), nil
+}
+
 func sendChanMessage() chan struct{} {
 	ch := make(chan struct{}, 1)
 	ch <- struct{}{}
@@ -6,6 +6,8 @@
 package require
 
 import (
+	"context"
+	"io/fs"
 	"iter"
 	"net/http"
 	"net/url"
@@ -43,6 +45,20 @@ func BlockedTf[E any, CHAN ~chan E](t T, ch CHAN, msg string, args ...any) {
 	t.FailNow()
 }
 
+// CompletesWithinf is the same as [CompletesWithin], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func CompletesWithinf(t T, d time.Duration, fn func(), msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.CompletesWithin(t, d, fn, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // Conditionf is the same as [Condition], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -85,6 +101,20 @@ func Containsf(t T, s any, contains any, msg string, args ...any) {
 	t.FailNow()
 }
 
+// DirEqualf is the same as [DirEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func DirEqualf(t T, expected string, actual string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.DirEqual(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // DirExistsf is the same as [DirExists], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -197,6 +227,20 @@ func EqualExportedValuesf(t T, expected any, actual any, msg string, args ...any
 	t.FailNow()
 }
 
+// EqualIgnoringFieldsf is the same as [EqualIgnoringFields], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func EqualIgnoringFieldsf(t T, expected any, actual any, fields []string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.EqualIgnoringFields(t, expected, actual, fields, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // EqualTf is the same as [EqualT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -253,6 +297,23 @@ func ErrorAsf(t T, err error, target any, msg string, args ...any) {
 	t.FailNow()
 }
 
+// ErrorAsTypef is the same as [ErrorAsType], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func ErrorAsTypef[E error](t T, err error, msg string, args ...any) E {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	value, ok := assertions.ErrorAsType[E](t, err, forwardArgs(msg, args)...)
+	if ok {
+		return value
+	}
+
+	t.FailNow()
+
+	return value
+}
+
 // ErrorContainsf is the same as [ErrorContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -309,6 +370,20 @@ func EventuallyWithf[C CollectibleConditioner](t T, condition C, timeout time.Du
 	t.FailNow()
 }
 
+// EventuallyWithContextf is the same as [EventuallyWithContext], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func EventuallyWithContextf[C Conditioner](t T, ctx context.Context, condition C, tick time.Duration, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.EventuallyWithContext[C](t, ctx, condition, tick, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // Exactlyf is the same as [Exactly], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -323,6 +398,20 @@ func Exactlyf(t T, expected any, actual any, msg string, args ...any) {
 	t.FailNow()
 }
 
+// FSEqualf is the same as [FSEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func FSEqualf(t T, expected fs.FS, actual fs.FS, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.FSEqual(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // Failf is the same as [Fail], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -389,6 +478,34 @@ func FileEmptyf(t T, path string, msg string, args ...any) {
 	t.FailNow()
 }
 
+// FileEqualf is the same as [FileEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func FileEqualf(t T, expected string, actual string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqual(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// FileEqualBytesf is the same as [FileEqualBytes], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func FileEqualBytesf(t T, expected []byte, actual string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqualBytes(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // FileExistsf is the same as [FileExists], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -487,6 +604,20 @@ func GreaterTf[Orderable Ordered](t T, e1 Orderable, e2 Orderable, msg string, a
 	t.FailNow()
 }
 
+// Groupf is the same as [Group], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func Groupf(t T, block func(*CollectT), msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.Group(t, block, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // HTTPBodyContainsf is the same as [HTTPBodyContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -501,6 +632,20 @@ func HTTPBodyContainsf(t T, handler http.HandlerFunc, method string, url string,
 	t.FailNow()
 }
 
+// HTTPBodyJSONEqf is the same as [HTTPBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func HTTPBodyJSONEqf(t T, handler http.HandlerFunc, method string, url string, values url.Values, expected string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPBodyJSONEq(t, handler, method, url, values, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // HTTPBodyNotContainsf is the same as [HTTPBodyNotContains], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -529,6 +674,20 @@ func HTTPErrorf(t T, handler http.HandlerFunc, method string, url string, values
 	t.FailNow()
 }
 
+// HTTPHeaderf is the same as [HTTPHeader], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func HTTPHeaderf(t T, handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPHeader(t, handler, method, url, values, header, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // HTTPRedirectf is the same as [HTTPRedirect], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -543,6 +702,34 @@ func HTTPRedirectf(t T, handler http.HandlerFunc, method string, url string, val
 	t.FailNow()
 }
 
+// HTTPRoundTripBodyJSONEqf is the same as [HTTPRoundTripBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func HTTPRoundTripBodyJSONEqf(t T, rt http.RoundTripper, req *http.Request, expected string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripBodyJSONEq(t, rt, req, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// HTTPRoundTripStatusCodef is the same as [HTTPRoundTripStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func HTTPRoundTripStatusCodef(t T, rt http.RoundTripper, req *http.Request, statuscode int, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripStatusCode(t, rt, req, statuscode, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // HTTPStatusCodef is the same as [HTTPStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -935,6 +1122,34 @@ func JSONMarshalAsTf[EDoc RText](t T, expected EDoc, object any, msg string, arg
 	t.FailNow()
 }
 
+// JSONMatchesf is the same as [JSONMatches], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func JSONMatchesf(t T, expected string, actual string, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.JSONMatches(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// JSONMatchesTf is the same as [JSONMatchesT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func JSONMatchesTf[EDoc, ADoc RText](t T, expected EDoc, actual ADoc, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.JSONMatchesT[EDoc, ADoc](t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // JSONUnmarshalAsTf is the same as [JSONUnmarshalAsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1061,6 +1276,20 @@ func MapEqualTf[K, V comparable](t T, listA map[K]V, listB map[K]V, msg string,
 	t.FailNow()
 }
 
+// MapLenTf is the same as [MapLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func MapLenTf[Map ~map[K]V, K comparable, V any](t T, m Map, length int, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.MapLenT[Map, K, V](t, m, length, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // MapNotContainsTf is the same as [MapNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1089,6 +1318,20 @@ func MapNotEqualTf[K, V comparable](t T, listA map[K]V, listB map[K]V, msg strin
 	t.FailNow()
 }
 
+// MaxAllocsPerRunf is the same as [MaxAllocsPerRun], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func MaxAllocsPerRunf(t T, maxAllocs int, fn func(), msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.MaxAllocsPerRun(t, maxAllocs, fn, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // Negativef is the same as [Negative], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1509,6 +1752,20 @@ func Panicsf(t T, f func(), msg string, args ...any) {
 	t.FailNow()
 }
 
+// PanicsMatchingf is the same as [PanicsMatching], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func PanicsMatchingf(t T, matcher func(any) bool, f func(), msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsMatching(t, matcher, f, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // PanicsWithErrorf is the same as [PanicsWithError], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1523,6 +1780,20 @@ func PanicsWithErrorf(t T, errString string, f func(), msg string, args ...any)
 	t.FailNow()
 }
 
+// PanicsWithErrorIsf is the same as [PanicsWithErrorIs], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func PanicsWithErrorIsf(t T, target error, f func(), msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsWithErrorIs(t, target, f, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // PanicsWithValuef is the same as [PanicsWithValue], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1565,6 +1836,34 @@ func PositiveTf[SignedNumber SignedNumeric](t T, e SignedNumber, msg string, arg
 	t.FailNow()
 }
 
+// ProtoEqualf is the same as [ProtoEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func ProtoEqualf(t T, expected any, actual any, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqual(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
+// ProtoEqualIgnoringUnknownf is the same as [ProtoEqualIgnoringUnknown], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func ProtoEqualIgnoringUnknownf(t T, expected any, actual any, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqualIgnoringUnknown(t, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // Regexpf is the same as [Regexp], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1635,6 +1934,20 @@ func SeqContainsTf[E comparable](t T, iter iter.Seq[E], element E, msg string, a
 	t.FailNow()
 }
 
+// SeqLenTf is the same as [SeqLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func SeqLenTf[E any](t T, iter iter.Seq[E], length int, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.SeqLenT[E](t, iter, length, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // SeqNotContainsTf is the same as [SeqNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1677,6 +1990,20 @@ func SliceEqualTf[E comparable](t T, listA []E, listB []E, msg string, args ...a
 	t.FailNow()
 }
 
+// SliceLenTf is the same as [SliceLenT], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func SliceLenTf[Slice ~[]E, E any](t T, s Slice, length int, msg string, args ...any) {
+	if h, ok := t.(H); ok {
+		h.Helper()
+	}
+	if assertions.SliceLenT[Slice, E](t, s, length, forwardArgs(msg, args)...) {
+		return
+	}
+
+	t.FailNow()
+}
+
 // SliceNotContainsTf is the same as [SliceNotContainsT], but it accepts a format string to format arguments like [fmt.Printf].
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -6,10 +6,13 @@
 package require
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"slices"
@@ -63,6 +66,29 @@ func TestBlockedTf(t *testing.T) {
 	})
 }
 
+func TestCompletesWithinf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		CompletesWithinf(mock, time.Second, func() {}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		CompletesWithinf(mock, 10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("CompletesWithinf should call FailNow()")
+		}
+	})
+}
+
 func TestConditionf(t *testing.T) {
 	t.Parallel()
 
@@ -132,6 +158,29 @@ func TestContainsf(t *testing.T) {
 	})
 }
 
+func TestDirEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		DirEqualf(mock, filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		DirEqualf(mock, testDataPath(), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("DirEqualf should call FailNow()")
+		}
+	})
+}
+
 func TestDirExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -316,6 +365,29 @@ func TestEqualExportedValuesf(t *testing.T) {
 	})
 }
 
+func TestEqualIgnoringFieldsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EqualIgnoringFieldsf(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EqualIgnoringFieldsf(mock, dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("EqualIgnoringFieldsf should call FailNow()")
+		}
+	})
+}
+
 func TestEqualTf(t *testing.T) {
 	t.Parallel()
 
@@ -408,6 +480,29 @@ func TestErrorAsf(t *testing.T) {
 	})
 }
 
+func TestErrorAsTypef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		ErrorAsTypef[*dummyError](mock, fmt.Errorf("wrap: %w", &dummyError{}), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		ErrorAsTypef[*dummyError](mock, ErrTest, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("ErrorAsTypef should call FailNow()")
+		}
+	})
+}
+
 func TestErrorContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -511,6 +606,29 @@ func TestEventuallyWithf(t *testing.T) {
 	})
 }
 
+func TestEventuallyWithContextf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EventuallyWithContextf(mock, context.Background(), func() bool { return true }, 20*time.Millisecond, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		EventuallyWithContextf(mock, func() context.Context { ctx, cancel := context.WithCancel(context.Background()); cancel(); return ctx }(), func() bool { return false }, 20*time.Millisecond, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("EventuallyWithContextf should call FailNow()")
+		}
+	})
+}
+
 func TestExactlyf(t *testing.T) {
 	t.Parallel()
 
@@ -534,6 +652,29 @@ func TestExactlyf(t *testing.T) {
 	})
 }
 
+func TestFSEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FSEqualf(mock, os.DirFS(testDataPath()), os.DirFS(testDataPath()), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FSEqualf(mock, os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FSEqualf should call FailNow()")
+		}
+	})
+}
+
 func TestFailf(t *testing.T) {
 	t.Parallel()
 
@@ -633,6 +774,52 @@ func TestFileEmptyf(t *testing.T) {
 	})
 }
 
+func TestFileEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualf(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualf(mock, filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FileEqualf should call FailNow()")
+		}
+	})
+}
+
+func TestFileEqualBytesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualBytesf(mock, []byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		FileEqualBytesf(mock, []byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("FileEqualBytesf should call FailNow()")
+		}
+	})
+}
+
 func TestFileExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -794,6 +981,29 @@ func TestGreaterTf(t *testing.T) {
 	})
 }
 
+func TestGroupf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Groupf(mock, func(c *CollectT) { True(c, true); Equal(c, 1, 1) }, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Groupf(mock, func(c *CollectT) { True(c, false); Equal(c, 1, 2) }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Groupf should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPBodyContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -817,6 +1027,29 @@ func TestHTTPBodyContainsf(t *testing.T) {
 	})
 }
 
+func TestHTTPBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPBodyJSONEqf(mock, httpJSON, "GET", "/", nil, `{"hello": "world"}`, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPBodyJSONEqf(mock, httpJSON, "GET", "/", nil, `{"hello": "bob"}`, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPBodyJSONEqf should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPBodyNotContainsf(t *testing.T) {
 	t.Parallel()
 
@@ -863,6 +1096,29 @@ func TestHTTPErrorf(t *testing.T) {
 	})
 }
 
+func TestHTTPHeaderf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPHeaderf(mock, httpJSON, "GET", "/", nil, "Content-Type", "application/json", "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPHeaderf(mock, httpJSON, "GET", "/", nil, "Content-Type", "text/plain", "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPHeaderf should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPRedirectf(t *testing.T) {
 	t.Parallel()
 
@@ -886,6 +1142,52 @@ func TestHTTPRedirectf(t *testing.T) {
 	})
 }
 
+func TestHTTPRoundTripBodyJSONEqf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripBodyJSONEqf(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripBodyJSONEqf(mock, httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPRoundTripBodyJSONEqf should call FailNow()")
+		}
+	})
+}
+
+func TestHTTPRoundTripStatusCodef(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripStatusCodef(mock, httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		HTTPRoundTripStatusCodef(mock, httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("HTTPRoundTripStatusCodef should call FailNow()")
+		}
+	})
+}
+
 func TestHTTPStatusCodef(t *testing.T) {
 	t.Parallel()
 
@@ -1530,6 +1832,52 @@ func TestJSONMarshalAsTf(t *testing.T) {
 	})
 }
 
+func TestJSONMatchesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesf(mock, `{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesf(mock, `{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("JSONMatchesf should call FailNow()")
+		}
+	})
+}
+
+func TestJSONMatchesTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesTf(mock, `{"hello": "world"}`, []byte(`{"foo": "bar", "hello": "world"}`), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		JSONMatchesTf(mock, `{"hello": "world", "foo": "bar"}`, []byte(`{"hello": "world"}`), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("JSONMatchesTf should call FailNow()")
+		}
+	})
+}
+
 func TestJSONUnmarshalAsTf(t *testing.T) {
 	t.Parallel()
 
@@ -1737,6 +2085,29 @@ func TestMapEqualTf(t *testing.T) {
 	})
 }
 
+func TestMapLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		MapLenTf(mock, map[string]string{"A": "B"}, 1, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		MapLenTf(mock, map[string]string{"A": "B"}, 2, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("MapLenTf should call FailNow()")
+		}
+	})
+}
+
 func TestMapNotContainsTf(t *testing.T) {
 	t.Parallel()
 
@@ -1783,6 +2154,23 @@ func TestMapNotEqualTf(t *testing.T) {
 	})
 }
 
+func TestMaxAllocsPerRunf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		MaxAllocsPerRunf(mock, 0, func() {}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		MaxAllocsPerRunf(mock, 0, func() { _ = make([]byte, 1<<20) }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("MaxAllocsPerRunf should call FailNow()")
+		}
+	})
+}
+
 func TestNegativef(t *testing.T) {
 	t.Parallel()
 
@@ -2451,6 +2839,29 @@ func TestPanicsf(t *testing.T) {
 	})
 }
 
+func TestPanicsMatchingf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsMatchingf(mock, func(v any) bool { return v == "panicking" }, func() { panic("panicking") }, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsMatchingf(mock, func(v any) bool { return v == "panicking" }, func() { panic("other") }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("PanicsMatchingf should call FailNow()")
+		}
+	})
+}
+
 func TestPanicsWithErrorf(t *testing.T) {
 	t.Parallel()
 
@@ -2474,6 +2885,29 @@ func TestPanicsWithErrorf(t *testing.T) {
 	})
 }
 
+func TestPanicsWithErrorIsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsWithErrorIsf(mock, ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		PanicsWithErrorIsf(mock, ErrTest, func() {}, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("PanicsWithErrorIsf should call FailNow()")
+		}
+	})
+}
+
 func TestPanicsWithValuef(t *testing.T) {
 	t.Parallel()
 
@@ -2543,6 +2977,38 @@ func TestPositiveTf(t *testing.T) {
 	})
 }
 
+func TestProtoEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Panics(t, func() {
+			ProtoEqualf(mock, "a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("ProtoEqualf should panic as expected")
+		}
+	})
+}
+
+func TestProtoEqualIgnoringUnknownf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		Panics(t, func() {
+			ProtoEqualIgnoringUnknownf(mock, "a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("ProtoEqualIgnoringUnknownf should panic as expected")
+		}
+	})
+}
+
 func TestRegexpf(t *testing.T) {
 	t.Parallel()
 
@@ -2658,6 +3124,29 @@ func TestSeqContainsTf(t *testing.T) {
 	})
 }
 
+func TestSeqLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SeqLenTf(mock, slices.Values([]string{"A", "B"}), 2, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SeqLenTf(mock, slices.Values([]string{"A", "B"}), 1, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("SeqLenTf should call FailNow()")
+		}
+	})
+}
+
 func TestSeqNotContainsTf(t *testing.T) {
 	t.Parallel()
 
@@ -2727,6 +3216,29 @@ func TestSliceEqualTf(t *testing.T) {
 	})
 }
 
+func TestSliceLenTf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SliceLenTf(mock, []string{"A", "B"}, 2, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		SliceLenTf(mock, []string{"A", "B"}, 1, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("SliceLenTf should call FailNow()")
+		}
+	})
+}
+
 func TestSliceNotContainsTf(t *testing.T) {
 	t.Parallel()
 
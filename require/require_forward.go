@@ -6,6 +6,7 @@
 package require
 
 import (
+	"io/fs"
 	"net/http"
 	"net/url"
 	"reflect"
@@ -24,7 +25,13 @@ type Assertions struct {
 }
 
 // New makes a new [Assertions] object for the specified [T] (e.g. [testing.T]).
-func New(t T) *Assertions {
+//
+// Options, e.g. [WithComparers], configure the assertions run with t.
+func New(t T, opts ...Option) *Assertions {
+	for _, apply := range opts {
+		apply(t)
+	}
+
 	return &Assertions{
 		T: t,
 	}
@@ -58,6 +65,34 @@ func (a *Assertions) Blockedf(ch any, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// CompletesWithin is the same as [CompletesWithin], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) CompletesWithin(d time.Duration, fn func(), msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.CompletesWithin(a.T, d, fn, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// CompletesWithinf is the same as [Assertions.CompletesWithin], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) CompletesWithinf(d time.Duration, fn func(), msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.CompletesWithin(a.T, d, fn, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // Condition is the same as [Condition], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -114,6 +149,34 @@ func (a *Assertions) Containsf(s any, contains any, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// DirEqual is the same as [DirEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) DirEqual(expected string, actual string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.DirEqual(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// DirEqualf is the same as [Assertions.DirEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) DirEqualf(expected string, actual string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.DirEqual(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // DirExists is the same as [DirExists], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -310,6 +373,34 @@ func (a *Assertions) EqualExportedValuesf(expected any, actual any, msg string,
 	a.T.FailNow()
 }
 
+// EqualIgnoringFields is the same as [EqualIgnoringFields], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) EqualIgnoringFields(expected any, actual any, fields []string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.EqualIgnoringFields(a.T, expected, actual, fields, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// EqualIgnoringFieldsf is the same as [Assertions.EqualIgnoringFields], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) EqualIgnoringFieldsf(expected any, actual any, fields []string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.EqualIgnoringFields(a.T, expected, actual, fields, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // EqualValues is the same as [EqualValues], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -478,6 +569,34 @@ func (a *Assertions) Exactlyf(expected any, actual any, msg string, args ...any)
 	a.T.FailNow()
 }
 
+// FSEqual is the same as [FSEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FSEqual(expected fs.FS, actual fs.FS, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FSEqual(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// FSEqualf is the same as [Assertions.FSEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FSEqualf(expected fs.FS, actual fs.FS, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FSEqual(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // Fail is the same as [Fail], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -582,6 +701,62 @@ func (a *Assertions) FileEmptyf(path string, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// FileEqual is the same as [FileEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FileEqual(expected string, actual string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqual(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// FileEqualf is the same as [Assertions.FileEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FileEqualf(expected string, actual string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqual(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// FileEqualBytes is the same as [FileEqualBytes], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FileEqualBytes(expected []byte, actual string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqualBytes(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// FileEqualBytesf is the same as [Assertions.FileEqualBytes], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) FileEqualBytesf(expected []byte, actual string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.FileEqualBytes(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // FileExists is the same as [FileExists], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -722,6 +897,34 @@ func (a *Assertions) GreaterOrEqualf(e1 any, e2 any, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// Group is the same as [Group], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) Group(block func(*CollectT), msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.Group(a.T, block, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// Groupf is the same as [Assertions.Group], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) Groupf(block func(*CollectT), msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.Group(a.T, block, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // HTTPBodyContains is the same as [HTTPBodyContains], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -750,6 +953,34 @@ func (a *Assertions) HTTPBodyContainsf(handler http.HandlerFunc, method string,
 	a.T.FailNow()
 }
 
+// HTTPBodyJSONEq is the same as [HTTPBodyJSONEq], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPBodyJSONEq(handler http.HandlerFunc, method string, url string, values url.Values, expected string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPBodyJSONEq(a.T, handler, method, url, values, expected, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// HTTPBodyJSONEqf is the same as [Assertions.HTTPBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPBodyJSONEqf(handler http.HandlerFunc, method string, url string, values url.Values, expected string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPBodyJSONEq(a.T, handler, method, url, values, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // HTTPBodyNotContains is the same as [HTTPBodyNotContains], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -806,6 +1037,34 @@ func (a *Assertions) HTTPErrorf(handler http.HandlerFunc, method string, url str
 	a.T.FailNow()
 }
 
+// HTTPHeader is the same as [HTTPHeader], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPHeader(handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPHeader(a.T, handler, method, url, values, header, expected, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// HTTPHeaderf is the same as [Assertions.HTTPHeader], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPHeaderf(handler http.HandlerFunc, method string, url string, values url.Values, header string, expected string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPHeader(a.T, handler, method, url, values, header, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // HTTPRedirect is the same as [HTTPRedirect], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -834,6 +1093,62 @@ func (a *Assertions) HTTPRedirectf(handler http.HandlerFunc, method string, url
 	a.T.FailNow()
 }
 
+// HTTPRoundTripBodyJSONEq is the same as [HTTPRoundTripBodyJSONEq], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPRoundTripBodyJSONEq(rt http.RoundTripper, req *http.Request, expected string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripBodyJSONEq(a.T, rt, req, expected, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// HTTPRoundTripBodyJSONEqf is the same as [Assertions.HTTPRoundTripBodyJSONEq], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPRoundTripBodyJSONEqf(rt http.RoundTripper, req *http.Request, expected string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripBodyJSONEq(a.T, rt, req, expected, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// HTTPRoundTripStatusCode is the same as [HTTPRoundTripStatusCode], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPRoundTripStatusCode(rt http.RoundTripper, req *http.Request, statuscode int, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripStatusCode(a.T, rt, req, statuscode, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// HTTPRoundTripStatusCodef is the same as [Assertions.HTTPRoundTripStatusCode], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) HTTPRoundTripStatusCodef(rt http.RoundTripper, req *http.Request, statuscode int, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.HTTPRoundTripStatusCode(a.T, rt, req, statuscode, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // HTTPStatusCode is the same as [HTTPStatusCode], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1310,6 +1625,34 @@ func (a *Assertions) JSONEqBytesf(expected []byte, actual []byte, msg string, ar
 	a.T.FailNow()
 }
 
+// JSONMatches is the same as [JSONMatches], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) JSONMatches(expected string, actual string, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.JSONMatches(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// JSONMatchesf is the same as [Assertions.JSONMatches], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) JSONMatchesf(expected string, actual string, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.JSONMatches(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // Kind is the same as [Kind], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -1422,6 +1765,34 @@ func (a *Assertions) LessOrEqualf(e1 any, e2 any, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// MaxAllocsPerRun is the same as [MaxAllocsPerRun], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) MaxAllocsPerRun(maxAllocs int, fn func(), msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.MaxAllocsPerRun(a.T, maxAllocs, fn, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// MaxAllocsPerRunf is the same as [Assertions.MaxAllocsPerRun], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) MaxAllocsPerRunf(maxAllocs int, fn func(), msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.MaxAllocsPerRun(a.T, maxAllocs, fn, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // Negative is the same as [Negative], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -2038,6 +2409,34 @@ func (a *Assertions) Panicsf(f func(), msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// PanicsMatching is the same as [PanicsMatching], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) PanicsMatching(matcher func(any) bool, f func(), msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsMatching(a.T, matcher, f, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// PanicsMatchingf is the same as [Assertions.PanicsMatching], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) PanicsMatchingf(matcher func(any) bool, f func(), msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsMatching(a.T, matcher, f, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // PanicsWithError is the same as [PanicsWithError], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -2066,6 +2465,34 @@ func (a *Assertions) PanicsWithErrorf(errString string, f func(), msg string, ar
 	a.T.FailNow()
 }
 
+// PanicsWithErrorIs is the same as [PanicsWithErrorIs], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) PanicsWithErrorIs(target error, f func(), msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsWithErrorIs(a.T, target, f, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// PanicsWithErrorIsf is the same as [Assertions.PanicsWithErrorIs], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) PanicsWithErrorIsf(target error, f func(), msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.PanicsWithErrorIs(a.T, target, f, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // PanicsWithValue is the same as [PanicsWithValue], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
@@ -2122,6 +2549,62 @@ func (a *Assertions) Positivef(e any, msg string, args ...any) {
 	a.T.FailNow()
 }
 
+// ProtoEqual is the same as [ProtoEqual], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) ProtoEqual(expected any, actual any, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqual(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// ProtoEqualf is the same as [Assertions.ProtoEqual], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) ProtoEqualf(expected any, actual any, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqual(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// ProtoEqualIgnoringUnknown is the same as [ProtoEqualIgnoringUnknown], as a method rather than a package-level function.
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) ProtoEqualIgnoringUnknown(expected any, actual any, msgAndArgs ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqualIgnoringUnknown(a.T, expected, actual, msgAndArgs...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
+// ProtoEqualIgnoringUnknownf is the same as [Assertions.ProtoEqualIgnoringUnknown], but it accepts a format string to format arguments like [fmt.Printf].
+//
+// Upon failure, the test [T] is marked as failed and stops execution.
+func (a *Assertions) ProtoEqualIgnoringUnknownf(expected any, actual any, msg string, args ...any) {
+	if h, ok := a.T.(H); ok {
+		h.Helper()
+	}
+	if assertions.ProtoEqualIgnoringUnknown(a.T, expected, actual, forwardArgs(msg, args)...) {
+		return
+	}
+
+	a.T.FailNow()
+}
+
 // Regexp is the same as [Regexp], as a method rather than a package-level function.
 //
 // Upon failure, the test [T] is marked as failed and stops execution.
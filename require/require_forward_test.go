@@ -6,10 +6,13 @@
 package require
 
 import (
+	"context"
 	"fmt"
 	"io"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"os"
 	"path/filepath"
 	"reflect"
 	"testing"
@@ -41,6 +44,31 @@ func TestAssertionsBlocked(t *testing.T) {
 	})
 }
 
+func TestAssertionsCompletesWithin(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.CompletesWithin(time.Second, func() {})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.CompletesWithin(10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.CompletesWithin should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsCondition(t *testing.T) {
 	t.Parallel()
 
@@ -91,6 +119,31 @@ func TestAssertionsContains(t *testing.T) {
 	})
 }
 
+func TestAssertionsDirEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.DirEqual(filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.DirEqual(testDataPath(), filepath.Join(testDataPath(), "existing_dir"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.DirEqual should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsDirExists(t *testing.T) {
 	t.Parallel()
 
@@ -266,6 +319,31 @@ func TestAssertionsEqualExportedValues(t *testing.T) {
 	})
 }
 
+func TestAssertionsEqualIgnoringFields(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualIgnoringFields(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualIgnoringFields(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"})
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.EqualIgnoringFields should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsEqualValues(t *testing.T) {
 	t.Parallel()
 
@@ -416,6 +494,31 @@ func TestAssertionsExactly(t *testing.T) {
 	})
 }
 
+func TestAssertionsFSEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FSEqual(os.DirFS(testDataPath()), os.DirFS(testDataPath()))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FSEqual(os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.FSEqual should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsFail(t *testing.T) {
 	t.Parallel()
 
@@ -498,6 +601,56 @@ func TestAssertionsFileEmpty(t *testing.T) {
 	})
 }
 
+func TestAssertionsFileEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FileEqual(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FileEqual(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.FileEqual should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsFileEqualBytes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FileEqualBytes([]byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.FileEqualBytes([]byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"))
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.FileEqualBytes should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsFileExists(t *testing.T) {
 	t.Parallel()
 
@@ -623,6 +776,31 @@ func TestAssertionsGreaterOrEqual(t *testing.T) {
 	})
 }
 
+func TestAssertionsGroup(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Group(func(c *CollectT) { True(c, true); Equal(c, 1, 1) })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Group(func(c *CollectT) { True(c, false); Equal(c, 1, 2) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.Group should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyContains(t *testing.T) {
 	t.Parallel()
 
@@ -648,6 +826,31 @@ func TestAssertionsHTTPBodyContains(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPBodyJSONEq(httpJSON, "GET", "/", nil, `{"hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPBodyJSONEq(httpJSON, "GET", "/", nil, `{"hello": "bob"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.HTTPBodyJSONEq should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsHTTPBodyNotContains(t *testing.T) {
 	t.Parallel()
 
@@ -698,6 +901,31 @@ func TestAssertionsHTTPError(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPHeader(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPHeader(httpJSON, "GET", "/", nil, "Content-Type", "application/json")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPHeader(httpJSON, "GET", "/", nil, "Content-Type", "text/plain")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.HTTPHeader should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsHTTPRedirect(t *testing.T) {
 	t.Parallel()
 
@@ -723,6 +951,56 @@ func TestAssertionsHTTPRedirect(t *testing.T) {
 	})
 }
 
+func TestAssertionsHTTPRoundTripBodyJSONEq(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPRoundTripBodyJSONEq(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPRoundTripBodyJSONEq(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripBodyJSONEq should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsHTTPRoundTripStatusCode(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPRoundTripStatusCode(httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.HTTPRoundTripStatusCode(httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.HTTPRoundTripStatusCode should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsHTTPStatusCode(t *testing.T) {
 	t.Parallel()
 
@@ -1148,6 +1426,31 @@ func TestAssertionsJSONEqBytes(t *testing.T) {
 	})
 }
 
+func TestAssertionsJSONMatches(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.JSONMatches(`{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`)
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.JSONMatches(`{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`)
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.JSONMatches should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsKind(t *testing.T) {
 	t.Parallel()
 
@@ -1248,11 +1551,30 @@ func TestAssertionsLessOrEqual(t *testing.T) {
 	})
 }
 
-func TestAssertionsNegative(t *testing.T) {
-	t.Parallel()
-
+func TestAssertionsMaxAllocsPerRun(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
-		t.Parallel()
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.MaxAllocsPerRun(0, func() {})
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.MaxAllocsPerRun(0, func() { _ = make([]byte, 1<<20) })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.MaxAllocsPerRun should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsNegative(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
 
 		mock := new(mockFailNowT)
 		a := New(mock)
@@ -1774,6 +2096,31 @@ func TestAssertionsPanics(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsMatching(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsMatching(func(v any) bool { return v == "panicking" }, func() { panic("panicking") })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsMatching(func(v any) bool { return v == "panicking" }, func() { panic("other") })
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.PanicsMatching should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithError(t *testing.T) {
 	t.Parallel()
 
@@ -1799,6 +2146,31 @@ func TestAssertionsPanicsWithError(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsWithErrorIs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsWithErrorIs(ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) })
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsWithErrorIs(ErrTest, func() {})
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.PanicsWithErrorIs should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithValue(t *testing.T) {
 	t.Parallel()
 
@@ -1849,6 +2221,40 @@ func TestAssertionsPositive(t *testing.T) {
 	})
 }
 
+func TestAssertionsProtoEqual(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Panics(func() {
+			a.ProtoEqual("a", "a")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("Assertions.ProtoEqual should panic as expected")
+		}
+	})
+}
+
+func TestAssertionsProtoEqualIgnoringUnknown(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Panics(func() {
+			a.ProtoEqualIgnoringUnknown("a", "a")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualIgnoringUnknown should panic as expected")
+		}
+	})
+}
+
 func TestAssertionsRegexp(t *testing.T) {
 	t.Parallel()
 
@@ -2083,6 +2489,31 @@ func TestAssertionsBlockedf(t *testing.T) {
 	})
 }
 
+func TestAssertionsCompletesWithinf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.CompletesWithinf(time.Second, func() {}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.CompletesWithinf(10*time.Millisecond, func() { time.Sleep(100 * time.Millisecond) }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.CompletesWithinf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsConditionf(t *testing.T) {
 	t.Parallel()
 
@@ -2133,6 +2564,31 @@ func TestAssertionsContainsf(t *testing.T) {
 	})
 }
 
+func TestAssertionsDirEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.DirEqualf(filepath.Join(testDataPath(), "existing_dir"), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.DirEqualf(testDataPath(), filepath.Join(testDataPath(), "existing_dir"), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.DirEqualf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsDirExistsf(t *testing.T) {
 	t.Parallel()
 
@@ -2241,7 +2697,232 @@ func TestAssertionsEqualf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Equalf(123, 123, "test message")
+		a.Equalf(123, 123, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Equalf(123, 456, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.Equalf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsEqualErrorf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualErrorf(ErrTest, "assert.ErrTest general error for testing", "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualErrorf(ErrTest, "wrong error message", "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.EqualErrorf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsEqualExportedValuesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualExportedValuesf(&dummyStruct{A: "a", b: 1}, &dummyStruct{A: "a", b: 2}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualExportedValuesf(&dummyStruct{A: "a", b: 1}, &dummyStruct{A: "b", b: 1}, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.EqualExportedValuesf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsEqualIgnoringFieldsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualIgnoringFieldsf(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 1}, []string{"A"}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualIgnoringFieldsf(dummyStruct{A: "a", b: 1}, dummyStruct{A: "b", b: 2}, []string{"A"}, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.EqualIgnoringFieldsf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsEqualValuesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualValuesf(uint32(123), int32(123), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.EqualValuesf(uint32(123), int32(456), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.EqualValuesf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsErrorf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Errorf(ErrTest, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Errorf(nil, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.Errorf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsErrorAsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorAsf(fmt.Errorf("wrap: %w", &dummyError{}), new(*dummyError), "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorAsf(ErrTest, new(*dummyError), "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.ErrorAsf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsErrorContainsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorContainsf(ErrTest, "general error", "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorContainsf(ErrTest, "not in message", "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.ErrorContainsf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsErrorIsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorIsf(fmt.Errorf("wrap: %w", io.EOF), io.EOF, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.ErrorIsf(ErrTest, io.EOF, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.ErrorIsf should call FailNow()")
+		}
+	})
+}
+
+func TestAssertionsExactlyf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Exactlyf(int32(123), int32(123), "test message")
 		// require functions don't return a value
 	})
 
@@ -2250,15 +2931,15 @@ func TestAssertionsEqualf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Equalf(123, 456, "test message")
+		a.Exactlyf(int32(123), int64(123), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.Equalf should call FailNow()")
+			t.Error("Assertions.Exactlyf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsEqualErrorf(t *testing.T) {
+func TestAssertionsFSEqualf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2266,7 +2947,7 @@ func TestAssertionsEqualErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualErrorf(ErrTest, "assert.ErrTest general error for testing", "test message")
+		a.FSEqualf(os.DirFS(testDataPath()), os.DirFS(testDataPath()), "test message")
 		// require functions don't return a value
 	})
 
@@ -2275,40 +2956,47 @@ func TestAssertionsEqualErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualErrorf(ErrTest, "wrong error message", "test message")
+		a.FSEqualf(os.DirFS(testDataPath()), os.DirFS(filepath.Join(testDataPath(), "existing_dir")), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.EqualErrorf should call FailNow()")
+			t.Error("Assertions.FSEqualf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsEqualExportedValuesf(t *testing.T) {
+func TestAssertionsFailf(t *testing.T) {
 	t.Parallel()
 
-	t.Run("success", func(t *testing.T) {
+	t.Run("failure", func(t *testing.T) {
 		t.Parallel()
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualExportedValuesf(&dummyStruct{A: "a", b: 1}, &dummyStruct{A: "a", b: 2}, "test message")
+		a.Failf("failed", "test message")
 		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.Failf should call FailNow()")
+		}
 	})
+}
+
+func TestAssertionsFailNowf(t *testing.T) {
+	t.Parallel()
 
 	t.Run("failure", func(t *testing.T) {
 		t.Parallel()
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualExportedValuesf(&dummyStruct{A: "a", b: 1}, &dummyStruct{A: "b", b: 1}, "test message")
+		a.FailNowf("failed", "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.EqualExportedValuesf should call FailNow()")
+			t.Error("Assertions.FailNowf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsEqualValuesf(t *testing.T) {
+func TestAssertionsFalsef(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2316,7 +3004,7 @@ func TestAssertionsEqualValuesf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualValuesf(uint32(123), int32(123), "test message")
+		a.Falsef(1 == 0, "test message")
 		// require functions don't return a value
 	})
 
@@ -2325,15 +3013,15 @@ func TestAssertionsEqualValuesf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.EqualValuesf(uint32(123), int32(456), "test message")
+		a.Falsef(1 == 1, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.EqualValuesf should call FailNow()")
+			t.Error("Assertions.Falsef should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsErrorf(t *testing.T) {
+func TestAssertionsFileEmptyf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2341,7 +3029,7 @@ func TestAssertionsErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Errorf(ErrTest, "test message")
+		a.FileEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
 		// require functions don't return a value
 	})
 
@@ -2350,15 +3038,15 @@ func TestAssertionsErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Errorf(nil, "test message")
+		a.FileEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.Errorf should call FailNow()")
+			t.Error("Assertions.FileEmptyf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsErrorAsf(t *testing.T) {
+func TestAssertionsFileEqualf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2366,7 +3054,7 @@ func TestAssertionsErrorAsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorAsf(fmt.Errorf("wrap: %w", &dummyError{}), new(*dummyError), "test message")
+		a.FileEqualf(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 	})
 
@@ -2375,15 +3063,15 @@ func TestAssertionsErrorAsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorAsf(ErrTest, new(*dummyError), "test message")
+		a.FileEqualf(filepath.Join(testDataPath(), "existing_file"), filepath.Join(testDataPath(), "empty_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.ErrorAsf should call FailNow()")
+			t.Error("Assertions.FileEqualf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsErrorContainsf(t *testing.T) {
+func TestAssertionsFileEqualBytesf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2391,7 +3079,7 @@ func TestAssertionsErrorContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorContainsf(ErrTest, "general error", "test message")
+		a.FileEqualBytesf([]byte("NOT EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 	})
 
@@ -2400,15 +3088,15 @@ func TestAssertionsErrorContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorContainsf(ErrTest, "not in message", "test message")
+		a.FileEqualBytesf([]byte("EMPTY\n"), filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.ErrorContainsf should call FailNow()")
+			t.Error("Assertions.FileEqualBytesf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsErrorIsf(t *testing.T) {
+func TestAssertionsFileExistsf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2416,7 +3104,7 @@ func TestAssertionsErrorIsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorIsf(fmt.Errorf("wrap: %w", io.EOF), io.EOF, "test message")
+		a.FileExistsf(filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 	})
 
@@ -2425,15 +3113,15 @@ func TestAssertionsErrorIsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.ErrorIsf(ErrTest, io.EOF, "test message")
+		a.FileExistsf(filepath.Join(testDataPath(), "non_existing_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.ErrorIsf should call FailNow()")
+			t.Error("Assertions.FileExistsf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsExactlyf(t *testing.T) {
+func TestAssertionsFileNotEmptyf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2441,7 +3129,7 @@ func TestAssertionsExactlyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Exactlyf(int32(123), int32(123), "test message")
+		a.FileNotEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 	})
 
@@ -2450,47 +3138,40 @@ func TestAssertionsExactlyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Exactlyf(int32(123), int64(123), "test message")
+		a.FileNotEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.Exactlyf should call FailNow()")
+			t.Error("Assertions.FileNotEmptyf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFailf(t *testing.T) {
+func TestAssertionsFileNotExistsf(t *testing.T) {
 	t.Parallel()
 
-	t.Run("failure", func(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Failf("failed", "test message")
+		a.FileNotExistsf(filepath.Join(testDataPath(), "non_existing_file"), "test message")
 		// require functions don't return a value
-		if !mock.failed {
-			t.Error("Assertions.Failf should call FailNow()")
-		}
 	})
-}
-
-func TestAssertionsFailNowf(t *testing.T) {
-	t.Parallel()
 
 	t.Run("failure", func(t *testing.T) {
 		t.Parallel()
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FailNowf("failed", "test message")
+		a.FileNotExistsf(filepath.Join(testDataPath(), "existing_file"), "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.FailNowf should call FailNow()")
+			t.Error("Assertions.FileNotExistsf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFalsef(t *testing.T) {
+func TestAssertionsGreaterf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2498,7 +3179,7 @@ func TestAssertionsFalsef(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Falsef(1 == 0, "test message")
+		a.Greaterf(2, 1, "test message")
 		// require functions don't return a value
 	})
 
@@ -2507,15 +3188,15 @@ func TestAssertionsFalsef(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Falsef(1 == 1, "test message")
+		a.Greaterf(1, 2, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.Falsef should call FailNow()")
+			t.Error("Assertions.Greaterf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFileEmptyf(t *testing.T) {
+func TestAssertionsGreaterOrEqualf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2523,7 +3204,7 @@ func TestAssertionsFileEmptyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
+		a.GreaterOrEqualf(2, 1, "test message")
 		// require functions don't return a value
 	})
 
@@ -2532,15 +3213,15 @@ func TestAssertionsFileEmptyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
+		a.GreaterOrEqualf(1, 2, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.FileEmptyf should call FailNow()")
+			t.Error("Assertions.GreaterOrEqualf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFileExistsf(t *testing.T) {
+func TestAssertionsGroupf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2548,7 +3229,7 @@ func TestAssertionsFileExistsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileExistsf(filepath.Join(testDataPath(), "existing_file"), "test message")
+		a.Groupf(func(c *CollectT) { True(c, true); Equal(c, 1, 1) }, "test message")
 		// require functions don't return a value
 	})
 
@@ -2557,15 +3238,15 @@ func TestAssertionsFileExistsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileExistsf(filepath.Join(testDataPath(), "non_existing_file"), "test message")
+		a.Groupf(func(c *CollectT) { True(c, false); Equal(c, 1, 2) }, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.FileExistsf should call FailNow()")
+			t.Error("Assertions.Groupf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFileNotEmptyf(t *testing.T) {
+func TestAssertionsHTTPBodyContainsf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2573,7 +3254,7 @@ func TestAssertionsFileNotEmptyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileNotEmptyf(filepath.Join(testDataPath(), "existing_file"), "test message")
+		a.HTTPBodyContainsf(httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, World!", "test message")
 		// require functions don't return a value
 	})
 
@@ -2582,15 +3263,15 @@ func TestAssertionsFileNotEmptyf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileNotEmptyf(filepath.Join(testDataPath(), "empty_file"), "test message")
+		a.HTTPBodyContainsf(httpBody, "GET", "/", url.Values{"name": []string{"Bob"}}, "Hello, World!", "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.FileNotEmptyf should call FailNow()")
+			t.Error("Assertions.HTTPBodyContainsf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsFileNotExistsf(t *testing.T) {
+func TestAssertionsHTTPBodyJSONEqf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2598,7 +3279,7 @@ func TestAssertionsFileNotExistsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileNotExistsf(filepath.Join(testDataPath(), "non_existing_file"), "test message")
+		a.HTTPBodyJSONEqf(httpJSON, "GET", "/", nil, `{"hello": "world"}`, "test message")
 		// require functions don't return a value
 	})
 
@@ -2607,15 +3288,15 @@ func TestAssertionsFileNotExistsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.FileNotExistsf(filepath.Join(testDataPath(), "existing_file"), "test message")
+		a.HTTPBodyJSONEqf(httpJSON, "GET", "/", nil, `{"hello": "bob"}`, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.FileNotExistsf should call FailNow()")
+			t.Error("Assertions.HTTPBodyJSONEqf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsGreaterf(t *testing.T) {
+func TestAssertionsHTTPBodyNotContainsf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2623,7 +3304,7 @@ func TestAssertionsGreaterf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Greaterf(2, 1, "test message")
+		a.HTTPBodyNotContainsf(httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, Bob!", "test message")
 		// require functions don't return a value
 	})
 
@@ -2632,15 +3313,15 @@ func TestAssertionsGreaterf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.Greaterf(1, 2, "test message")
+		a.HTTPBodyNotContainsf(httpBody, "GET", "/", url.Values{"name": []string{"Bob"}}, "Hello, Bob!", "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.Greaterf should call FailNow()")
+			t.Error("Assertions.HTTPBodyNotContainsf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsGreaterOrEqualf(t *testing.T) {
+func TestAssertionsHTTPErrorf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2648,7 +3329,7 @@ func TestAssertionsGreaterOrEqualf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.GreaterOrEqualf(2, 1, "test message")
+		a.HTTPErrorf(httpError, "GET", "/", nil, "test message")
 		// require functions don't return a value
 	})
 
@@ -2657,15 +3338,15 @@ func TestAssertionsGreaterOrEqualf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.GreaterOrEqualf(1, 2, "test message")
+		a.HTTPErrorf(httpOK, "GET", "/", nil, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.GreaterOrEqualf should call FailNow()")
+			t.Error("Assertions.HTTPErrorf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsHTTPBodyContainsf(t *testing.T) {
+func TestAssertionsHTTPHeaderf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2673,7 +3354,7 @@ func TestAssertionsHTTPBodyContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPBodyContainsf(httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, World!", "test message")
+		a.HTTPHeaderf(httpJSON, "GET", "/", nil, "Content-Type", "application/json", "test message")
 		// require functions don't return a value
 	})
 
@@ -2682,15 +3363,15 @@ func TestAssertionsHTTPBodyContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPBodyContainsf(httpBody, "GET", "/", url.Values{"name": []string{"Bob"}}, "Hello, World!", "test message")
+		a.HTTPHeaderf(httpJSON, "GET", "/", nil, "Content-Type", "text/plain", "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.HTTPBodyContainsf should call FailNow()")
+			t.Error("Assertions.HTTPHeaderf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsHTTPBodyNotContainsf(t *testing.T) {
+func TestAssertionsHTTPRedirectf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2698,7 +3379,7 @@ func TestAssertionsHTTPBodyNotContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPBodyNotContainsf(httpBody, "GET", "/", url.Values{"name": []string{"World"}}, "Hello, Bob!", "test message")
+		a.HTTPRedirectf(httpRedirect, "GET", "/", nil, "test message")
 		// require functions don't return a value
 	})
 
@@ -2707,15 +3388,15 @@ func TestAssertionsHTTPBodyNotContainsf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPBodyNotContainsf(httpBody, "GET", "/", url.Values{"name": []string{"Bob"}}, "Hello, Bob!", "test message")
+		a.HTTPRedirectf(httpError, "GET", "/", nil, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.HTTPBodyNotContainsf should call FailNow()")
+			t.Error("Assertions.HTTPRedirectf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsHTTPErrorf(t *testing.T) {
+func TestAssertionsHTTPRoundTripBodyJSONEqf(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2723,7 +3404,7 @@ func TestAssertionsHTTPErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPErrorf(httpError, "GET", "/", nil, "test message")
+		a.HTTPRoundTripBodyJSONEqf(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "world"}`, "test message")
 		// require functions don't return a value
 	})
 
@@ -2732,15 +3413,15 @@ func TestAssertionsHTTPErrorf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPErrorf(httpOK, "GET", "/", nil, "test message")
+		a.HTTPRoundTripBodyJSONEqf(httpRoundTripper(httpJSON), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), `{"hello": "bob"}`, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.HTTPErrorf should call FailNow()")
+			t.Error("Assertions.HTTPRoundTripBodyJSONEqf should call FailNow()")
 		}
 	})
 }
 
-func TestAssertionsHTTPRedirectf(t *testing.T) {
+func TestAssertionsHTTPRoundTripStatusCodef(t *testing.T) {
 	t.Parallel()
 
 	t.Run("success", func(t *testing.T) {
@@ -2748,7 +3429,7 @@ func TestAssertionsHTTPRedirectf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPRedirectf(httpRedirect, "GET", "/", nil, "test message")
+		a.HTTPRoundTripStatusCodef(httpRoundTripper(httpOK), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
 		// require functions don't return a value
 	})
 
@@ -2757,10 +3438,10 @@ func TestAssertionsHTTPRedirectf(t *testing.T) {
 
 		mock := new(mockFailNowT)
 		a := New(mock)
-		a.HTTPRedirectf(httpError, "GET", "/", nil, "test message")
+		a.HTTPRoundTripStatusCodef(httpRoundTripper(httpError), httptest.NewRequestWithContext(context.Background(), "GET", "/", http.NoBody), http.StatusOK, "test message")
 		// require functions don't return a value
 		if !mock.failed {
-			t.Error("Assertions.HTTPRedirectf should call FailNow()")
+			t.Error("Assertions.HTTPRoundTripStatusCodef should call FailNow()")
 		}
 	})
 }
@@ -3190,6 +3871,31 @@ func TestAssertionsJSONEqBytesf(t *testing.T) {
 	})
 }
 
+func TestAssertionsJSONMatchesf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.JSONMatchesf(`{"hello": "world"}`, `{"foo": "bar", "hello": "world"}`, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.JSONMatchesf(`{"hello": "world", "foo": "bar"}`, `{"hello": "world"}`, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.JSONMatchesf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsKindf(t *testing.T) {
 	t.Parallel()
 
@@ -3290,6 +3996,25 @@ func TestAssertionsLessOrEqualf(t *testing.T) {
 	})
 }
 
+func TestAssertionsMaxAllocsPerRunf(t *testing.T) {
+	t.Run("success", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.MaxAllocsPerRunf(0, func() {}, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.MaxAllocsPerRunf(0, func() { _ = make([]byte, 1<<20) }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.MaxAllocsPerRunf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsNegativef(t *testing.T) {
 	t.Parallel()
 
@@ -3816,6 +4541,31 @@ func TestAssertionsPanicsf(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsMatchingf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsMatchingf(func(v any) bool { return v == "panicking" }, func() { panic("panicking") }, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsMatchingf(func(v any) bool { return v == "panicking" }, func() { panic("other") }, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.PanicsMatchingf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithErrorf(t *testing.T) {
 	t.Parallel()
 
@@ -3841,6 +4591,31 @@ func TestAssertionsPanicsWithErrorf(t *testing.T) {
 	})
 }
 
+func TestAssertionsPanicsWithErrorIsf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsWithErrorIsf(ErrTest, func() { panic(fmt.Errorf("wrap: %w", ErrTest)) }, "test message")
+		// require functions don't return a value
+	})
+
+	t.Run("failure", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.PanicsWithErrorIsf(ErrTest, func() {}, "test message")
+		// require functions don't return a value
+		if !mock.failed {
+			t.Error("Assertions.PanicsWithErrorIsf should call FailNow()")
+		}
+	})
+}
+
 func TestAssertionsPanicsWithValuef(t *testing.T) {
 	t.Parallel()
 
@@ -3891,6 +4666,40 @@ func TestAssertionsPositivef(t *testing.T) {
 	})
 }
 
+func TestAssertionsProtoEqualf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Panics(func() {
+			a.ProtoEqualf("a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualf should panic as expected")
+		}
+	})
+}
+
+func TestAssertionsProtoEqualIgnoringUnknownf(t *testing.T) {
+	t.Parallel()
+
+	t.Run("panic", func(t *testing.T) {
+		t.Parallel()
+
+		mock := new(mockFailNowT)
+		a := New(mock)
+		a.Panics(func() {
+			a.ProtoEqualIgnoringUnknownf("a", "a", "test message")
+		}, "should panic without the proto feature enabled.")
+		if mock.failed {
+			t.Error("Assertions.ProtoEqualIgnoringUnknownf should panic as expected")
+		}
+	})
+}
+
 func TestAssertionsRegexpf(t *testing.T) {
 	t.Parallel()
 
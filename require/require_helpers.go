@@ -19,6 +19,27 @@ func CallerInfo() []string {
 	return assertions.CallerInfo()
 }
 
+// CompareWith builds a [Comparer] that decides whether two values of type V are equal.
+//
+// The comparer applies to all values of type V found in the compared objects, at any depth,
+// including values held by unexported struct fields.
+// When V is an interface type, the comparer applies to all values implementing V.
+//
+// This allows a test to plug in domain-specific equality, e.g. for [time.Time] truncation,
+// decimal types or protobuf messages, or to delegate the comparison to another library
+// such as github.com/google/go-cmp:
+//
+//	assert.CompareWith(func(expected, actual time.Time) bool {
+//		return expected.Truncate(time.Second).Equal(actual.Truncate(time.Second))
+//	})
+//
+//	assert.CompareWith(func(expected, actual any) bool {
+//		return cmp.Equal(expected, actual, cmpopts.EquateEmpty())
+//	})
+func CompareWith[V any](equal func(expected V, actual V) bool) Comparer {
+	return assertions.CompareWith[V](equal)
+}
+
 // HTTPBody is a helper that returns the HTTP body of the response.
 // It returns the empty string if building a new request fails.
 func HTTPBody(handler http.HandlerFunc, method string, url string, values url.Values) string {
@@ -37,3 +58,67 @@ func ObjectsAreEqual(expected any, actual any) bool {
 func ObjectsAreEqualValues(expected any, actual any) bool {
 	return assertions.ObjectsAreEqualValues(expected, actual)
 }
+
+// RegisterFailureReporter registers a hook called with every failed assertion, in all tests.
+//
+// This is typically called from TestMain. The returned function unregisters the hook.
+//
+// # Usage
+//
+//	func TestMain(m *testing.M) {
+//		unregister := assert.RegisterFailureReporter(func(f assert.Failure) {
+//			fmt.Fprintf(os.Stderr, "::error file=%s,line=%d,title=%s::%s\n", f.File, f.Line, f.Assertion, f.Message)
+//		})
+//		code := m.Run()
+//		unregister()
+//		os.Exit(code)
+//	}
+func RegisterFailureReporter(reporter FailureReporter) (unregister func()) {
+	return assertions.RegisterFailureReporter(reporter)
+}
+
+// WithComparers configures custom comparers used by [Equal], [NotEqual], [EqualValues] and [NotEqualValues].
+//
+// Comparers apply to all assertions called with the same [T], until the test completes.
+// They are not inherited by subtests.
+//
+// When several comparers apply to the same type, the first one configured wins.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithComparers(
+//		assert.CompareWith(func(expected, actual *big.Int) bool { return expected.Cmp(actual) == 0 }),
+//	))
+//	a.Equal(big.NewInt(1), big.NewInt(1))
+func WithComparers(comparers ...Comparer) Option {
+	return assertions.WithComparers(comparers...)
+}
+
+// WithFailureReporter configures a hook called with every failed assertion run with a given [T].
+//
+// The hook applies until the test completes. It is not inherited by subtests.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithFailureReporter(func(f assert.Failure) {
+//		failures = append(failures, f)
+//	}))
+func WithFailureReporter(reporter FailureReporter) Option {
+	return assertions.WithFailureReporter(reporter)
+}
+
+// WithIgnoredFields configures struct fields ignored by [Equal], [NotEqual], [EqualValues] and [NotEqualValues].
+//
+// Like with [EqualIgnoringFields], the ignored fields are zeroed on copies of the compared objects.
+// Unlike [EqualIgnoringFields], a field name that doesn't match any exported struct field is not an error.
+//
+// Fields are ignored in all assertions called with the same [T], until the test completes.
+// They are not inherited by subtests.
+//
+// # Usage
+//
+//	a := assert.New(t, assert.WithIgnoredFields("ID", "CreatedAt"))
+//	a.Equal(expected, actual)
+func WithIgnoredFields(fields ...string) Option {
+	return assertions.WithIgnoredFields(fields...)
+}
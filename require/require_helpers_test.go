@@ -13,6 +13,10 @@ func TestCallerInfof(t *testing.T) {
 	t.Skip() // this function doesn't have tests yet
 }
 
+func TestCompareWithf(t *testing.T) {
+	t.Skip() // this function doesn't have tests yet
+}
+
 func TestHTTPBodyf(t *testing.T) {
 	t.Skip() // this function doesn't have tests yet
 }
@@ -24,3 +28,19 @@ func TestObjectsAreEqualf(t *testing.T) {
 func TestObjectsAreEqualValuesf(t *testing.T) {
 	t.Skip() // this function doesn't have tests yet
 }
+
+func TestRegisterFailureReporterf(t *testing.T) {
+	t.Skip() // this function doesn't have tests yet
+}
+
+func TestWithComparersf(t *testing.T) {
+	t.Skip() // this function doesn't have tests yet
+}
+
+func TestWithFailureReporterf(t *testing.T) {
+	t.Skip() // this function doesn't have tests yet
+}
+
+func TestWithIgnoredFieldsf(t *testing.T) {
+	t.Skip() // this function doesn't have tests yet
+}
@@ -0,0 +1,46 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Normalizer transforms a snapshot before comparison.
+type Normalizer func([]byte) ([]byte, error)
+
+// NormalizeJSON reformats a JSON document with sorted keys and a 2-space indentation.
+//
+// Numbers are preserved verbatim.
+func NormalizeJSON(content []byte) ([]byte, error) {
+	dec := json.NewDecoder(bytes.NewReader(content))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+
+	var normalized bytes.Buffer
+	enc := json.NewEncoder(&normalized)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+
+	return normalized.Bytes(), nil
+}
+
+// NormalizeText converts line endings to "\n" and trims trailing white space on every line.
+func NormalizeText(content []byte) ([]byte, error) {
+	content = bytes.ReplaceAll(content, []byte("\r\n"), []byte("\n"))
+	lines := bytes.Split(content, []byte("\n"))
+	for i, line := range lines {
+		lines[i] = bytes.TrimRight(line, " \t\r")
+	}
+
+	return bytes.Join(lines, []byte("\n")), nil
+}
@@ -0,0 +1,105 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"path/filepath"
+)
+
+// Option configures the behavior of [Match].
+type Option func(*options)
+
+type options struct {
+	dir         string
+	name        string
+	extension   string
+	update      *bool
+	normalizers []Normalizer
+}
+
+// WithDir sets the directory where golden files are stored.
+//
+// The default is "testdata".
+func WithDir(dir string) Option {
+	return func(o *options) {
+		o.dir = dir
+	}
+}
+
+// WithName sets the name of the golden file, without extension.
+//
+// The default is derived from the name of the test.
+// Use this option to store several snapshots for the same test.
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
+}
+
+// WithExtension sets the extension of the golden file, e.g. ".json" or ".html".
+//
+// The default is ".golden".
+func WithExtension(extension string) Option {
+	return func(o *options) {
+		o.extension = extension
+	}
+}
+
+// WithUpdate forces the update mode on or off, regardless of the -testify.update flag.
+func WithUpdate(enabled bool) Option {
+	return func(o *options) {
+		o.update = &enabled
+	}
+}
+
+// WithNormalizers applies normalizers, in order, to both the actual output
+// and the stored golden file before comparing them.
+//
+// Golden files are written normalized.
+func WithNormalizers(normalizers ...Normalizer) Option {
+	return func(o *options) {
+		o.normalizers = append(o.normalizers, normalizers...)
+	}
+}
+
+func optionsWithDefaults(t T, opts []Option) options {
+	o := options{
+		dir:       defaultDir,
+		extension: defaultExtension,
+	}
+
+	for _, apply := range opts {
+		apply(&o)
+	}
+
+	if o.name == "" {
+		o.name = goldenName(t.Name())
+	}
+
+	return o
+}
+
+func (o options) path() string {
+	return filepath.Join(o.dir, o.name+o.extension)
+}
+
+func (o options) isUpdate() bool {
+	if o.update != nil {
+		return *o.update
+	}
+
+	return *update
+}
+
+func (o options) normalize(content []byte) ([]byte, error) {
+	var err error
+	for _, normalizer := range o.normalizers {
+		content, err = normalizer(content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return content, nil
+}
@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot provides golden-file assertions.
+//
+// [Match] compares the output produced by a test with a golden file stored
+// under the testdata directory of the tested package.
+//
+// Golden files are created or refreshed by setting the TESTIFY_UPDATE environment variable:
+//
+//	TESTIFY_UPDATE=1 go test ./...
+//
+// The -testify.update flag does the same, but it is only defined in test binaries which
+// import this package: use it to update the snapshots of a single package.
+//
+//	go test ./mypackage -testify.update
+//
+// On mismatch, the failure reports a unified diff between the golden file and
+// the actual output. The diff is colorized whenever the enable/colors feature is enabled.
+//
+// Normalizers may be used to remove irrelevant differences before comparing,
+// e.g. JSON formatting or line endings.
+package snapshot
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-openapi/testify/v2/internal/assertions"
+)
+
+const (
+	updateFlag   = "testify.update"
+	envVarUpdate = "TESTIFY_UPDATE"
+
+	defaultDir       = "testdata"
+	defaultExtension = ".golden"
+)
+
+var update = flag.Bool(updateFlag, updateFromEnv(), "testify: create or update snapshot golden files") //nolint:gochecknoglobals // CLI flags are stored in a package global
+
+// T is the interface required by [Match]. It is satisfied by [testing.T] and [testing.B].
+type T interface {
+	Errorf(format string, args ...any)
+	Name() string
+}
+
+// Match asserts that got matches the golden file associated with the current test.
+//
+// By default, the golden file is located at testdata/<test name>.golden, relative to
+// the directory of the tested package. Subtests are stored in nested directories.
+//
+// When running in update mode, the golden file is (re)written with got and the assertion succeeds.
+//
+// When the golden file does not exist, the assertion fails and suggests to run with
+// the -testify.update flag.
+//
+// Use [WithName] to store several snapshots for the same test.
+//
+// # Usage
+//
+//	snapshot.Match(t, renderPage(), snapshot.WithExtension(".html"))
+func Match[D ~string | ~[]byte](t T, got D, opts ...Option) bool {
+	if h, ok := t.(assertions.H); ok {
+		h.Helper()
+	}
+
+	o := optionsWithDefaults(t, opts)
+	golden := o.path()
+
+	actual, err := o.normalize([]byte(got))
+	if err != nil {
+		return assertions.Fail(t, fmt.Sprintf("could not normalize snapshot %q: %v", golden, err))
+	}
+
+	if o.isUpdate() {
+		if err := writeGolden(golden, actual); err != nil {
+			return assertions.Fail(t, fmt.Sprintf("could not update snapshot %q: %v", golden, err))
+		}
+
+		return true
+	}
+
+	stored, err := os.ReadFile(golden)
+	if errors.Is(err, fs.ErrNotExist) {
+		return assertions.Fail(t, fmt.Sprintf("snapshot %q does not exist: run the test with -%s to create it", golden, updateFlag))
+	}
+	if err != nil {
+		return assertions.Fail(t, fmt.Sprintf("could not read snapshot %q: %v", golden, err))
+	}
+
+	expected, err := o.normalize(stored)
+	if err != nil {
+		return assertions.Fail(t, fmt.Sprintf("could not normalize snapshot %q: %v", golden, err))
+	}
+
+	return assertions.Equal(t, string(expected), string(actual),
+		fmt.Sprintf("snapshot %q does not match: run the test with -%s to update it", golden, updateFlag),
+	)
+}
+
+func writeGolden(golden string, content []byte) error {
+	const (
+		dirMode  = 0o755
+		fileMode = 0o644
+	)
+
+	if err := os.MkdirAll(filepath.Dir(golden), dirMode); err != nil {
+		return err
+	}
+
+	return os.WriteFile(golden, content, fileMode)
+}
+
+// goldenName builds a relative file path from a test name.
+//
+// Each subtest level becomes a directory. Characters which are not safe in
+// file names are replaced by an underscore.
+func goldenName(testName string) string {
+	parts := strings.Split(testName, "/")
+	for i, part := range parts {
+		part = strings.Map(func(r rune) rune {
+			switch {
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+				return r
+			case r == '-', r == '_', r == '.':
+				return r
+			default:
+				return '_'
+			}
+		}, part)
+
+		if part == "" || strings.Trim(part, ".") == "" {
+			part = strings.Repeat("_", max(len(part), 1))
+		}
+
+		parts[i] = part
+	}
+
+	return filepath.Join(parts...)
+}
+
+func updateFromEnv() bool {
+	isEnvUpdate, _ := strconv.ParseBool(os.Getenv(envVarUpdate))
+
+	return isEnvUpdate
+}
@@ -0,0 +1,211 @@
+// SPDX-FileCopyrightText: Copyright 2025 go-swagger maintainers
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/go-openapi/testify/v2/internal/assertions"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	t.Run("should create then match a golden file", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		mock := &mockT{name: "TestMatch/create"}
+
+		if !Match(mock, "hello\n", WithDir(dir), WithUpdate(true)) {
+			t.Fatalf("expected update to succeed, got %v", mock.errors)
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, "TestMatch", "create.golden"))
+		if err != nil {
+			t.Fatalf("expected golden file to be written: %v", err)
+		}
+		if string(content) != "hello\n" {
+			t.Errorf("unexpected golden content: %q", content)
+		}
+
+		if !Match(mock, []byte("hello\n"), WithDir(dir), WithUpdate(false)) {
+			t.Errorf("expected snapshot to match, got %v", mock.errors)
+		}
+	})
+
+	t.Run("should fail with a diff on mismatch", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		mock := &mockT{name: "TestMismatch"}
+		writeFile(t, filepath.Join(dir, "TestMismatch.golden"), "line 1\nline 2\n")
+
+		if Match(mock, "line 1\nline 3\n", WithDir(dir), WithUpdate(false)) {
+			t.Fatal("expected snapshot mismatch")
+		}
+
+		msg := mock.message()
+		for _, want := range []string{"does not match", "-" + updateFlag, "Diff:", "-line 2", "+line 3"} {
+			if !strings.Contains(msg, want) {
+				t.Errorf("expected failure message to contain %q, got:\n%s", want, msg)
+			}
+		}
+	})
+
+	t.Run("should fail when the golden file is missing", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockT{name: "TestMissing"}
+		if Match(mock, "anything", WithDir(t.TempDir()), WithUpdate(false)) {
+			t.Fatal("expected missing snapshot to fail")
+		}
+
+		if msg := mock.message(); !strings.Contains(msg, "does not exist") {
+			t.Errorf("expected failure message to report a missing snapshot, got:\n%s", msg)
+		}
+	})
+
+	t.Run("should use a custom name and extension", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		mock := &mockT{name: "TestCustom"}
+		if !Match(mock, "<p>ok</p>", WithDir(dir), WithName("page"), WithExtension(".html"), WithUpdate(true)) {
+			t.Fatalf("expected update to succeed, got %v", mock.errors)
+		}
+
+		if _, err := os.Stat(filepath.Join(dir, "page.html")); err != nil {
+			t.Errorf("expected custom golden file: %v", err)
+		}
+	})
+
+	t.Run("should apply normalizers on both sides", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		mock := &mockT{name: "TestNormalized"}
+		writeFile(t, filepath.Join(dir, "TestNormalized.golden"), `{"b":2,"a":"<1>"}`)
+
+		if !Match(mock, "{\r\n  \"a\": \"<1>\",  \r\n  \"b\": 2\r\n}\r\n", WithDir(dir), WithUpdate(false),
+			WithNormalizers(NormalizeText, NormalizeJSON),
+		) {
+			t.Errorf("expected normalized snapshots to match, got %v", mock.errors)
+		}
+	})
+
+	t.Run("should fail when normalization fails", func(t *testing.T) {
+		t.Parallel()
+
+		mock := &mockT{name: "TestInvalidJSON"}
+		if Match(mock, "{", WithDir(t.TempDir()), WithUpdate(true), WithNormalizers(NormalizeJSON)) {
+			t.Fatal("expected invalid JSON to fail")
+		}
+
+		if msg := mock.message(); !strings.Contains(msg, "could not normalize") {
+			t.Errorf("expected normalization failure, got:\n%s", msg)
+		}
+	})
+}
+
+func TestGoldenName(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		testName string
+		want     string
+	}{
+		{testName: "TestX", want: "TestX"},
+		{testName: "TestX/sub_test#01", want: filepath.Join("TestX", "sub_test_01")},
+		{testName: "TestX/..", want: filepath.Join("TestX", "__")},
+		{testName: "TestX/a:b", want: filepath.Join("TestX", "a_b")},
+	} {
+		if got := goldenName(tc.testName); got != tc.want {
+			t.Errorf("goldenName(%q): expected %q, got %q", tc.testName, tc.want, got)
+		}
+	}
+}
+
+func TestNormalizeText(t *testing.T) {
+	t.Parallel()
+
+	got, err := NormalizeText([]byte("a  \r\nb\t\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != "a\nb\n" {
+		t.Errorf("unexpected normalized text: %q", got)
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	t.Parallel()
+
+	got, err := NormalizeJSON([]byte(`{"z":1.50,"a":[true,null]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const want = "{\n  \"a\": [\n    true,\n    null\n  ],\n  \"z\": 1.50\n}\n"
+	if string(got) != want {
+		t.Errorf("unexpected normalized JSON: %q", got)
+	}
+}
+
+func TestMatchCallSite(t *testing.T) {
+	// not parallel: registers a global failure reporter
+	var failures []assertions.Failure
+	unregister := assertions.RegisterFailureReporter(func(f assertions.Failure) {
+		failures = append(failures, f)
+	})
+	defer unregister()
+
+	mock := &mockT{name: "TestCallSite"}
+	_, file, line, _ := runtime.Caller(0)
+	Match(mock, "hello\n", WithDir(t.TempDir()), WithUpdate(false))
+
+	if len(failures) != 1 {
+		t.Fatalf("expected 1 reported failure, got %d", len(failures))
+	}
+	if failures[0].Assertion != "Match" {
+		t.Errorf("expected assertion name to be Match, got %q", failures[0].Assertion)
+	}
+	if failures[0].File != file || failures[0].Line != line+1 {
+		t.Errorf("expected the call site to be %s:%d, got %s:%d", file, line+1, failures[0].File, failures[0].Line)
+	}
+	if msg := mock.message(); strings.Contains(msg, "snapshot.go:") {
+		t.Errorf("expected the error trace to skip the snapshot package, got:\n%s", msg)
+	}
+}
+
+type mockT struct {
+	name   string
+	errors []string
+}
+
+func (mockT) Helper() {}
+
+func (m *mockT) Name() string { return m.name }
+
+func (m *mockT) Errorf(format string, args ...any) {
+	m.errors = append(m.errors, fmt.Sprintf(format, args...))
+}
+
+func (m *mockT) message() string {
+	return strings.Join(m.errors, "\n")
+}
+
+func writeFile(t *testing.T, name, content string) {
+	t.Helper()
+
+	if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}